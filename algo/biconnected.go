@@ -0,0 +1,201 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Single points of failure: articulation points (nodes), bridges (edges) and
+ * biconnected components (blocks). Everything here works on the underlying
+ * undirected graph, so edge directions are ignored.
+ *
+ * All of them are found by one Tarjan DFS. Instead of skipping the parent
+ * node, DFS skips only the edge it came by. This way second parallel edge
+ * between the same nodes is a back edge, and such pair is never a bridge.
+ */
+
+type Block struct {
+	Nodes []graph.TKey
+	Edges []graph.TKey
+}
+
+type biconnectivity struct {
+	articulation []graph.TKey
+	bridges      []graph.TKey
+	blocks       []Block
+}
+
+func findBiconnectivity(gr *graph.Graph) biconnectivity {
+	arcs := undirectedArcs(gr)
+	disc := make(map[graph.TKey]int, len(gr.Nodes))
+	low := make(map[graph.TKey]int, len(gr.Nodes))
+	isCut := make(map[graph.TKey]bool)
+	inBlock := make(map[graph.TKey]bool, len(gr.Nodes))
+	result := biconnectivity{}
+	edgeStack := []graph.TKey{}
+	counter := 1
+
+	type frame struct {
+		node     graph.TKey
+		entry    graph.TKey // edge we came by
+		hasEntry bool
+		children int
+		next     int
+	}
+
+	popBlock := func(until graph.TKey) {
+		block := Block{}
+		seen := make(map[graph.TKey]bool)
+		for {
+			key := edgeStack[len(edgeStack)-1]
+			edgeStack = edgeStack[:len(edgeStack)-1]
+			block.Edges = append(block.Edges, key)
+			edge := gr.Edges[key]
+			for _, end := range []graph.TKey{edge.Source, edge.Destination} {
+				if !seen[end] {
+					seen[end] = true
+					inBlock[end] = true
+					block.Nodes = append(block.Nodes, end)
+				}
+			}
+			if key == until {
+				break
+			}
+		}
+		slices.Sort(block.Nodes)
+		slices.Sort(block.Edges)
+		result.blocks = append(result.blocks, block)
+	}
+
+	for _, root := range sortedNodeKeys(gr) {
+		if disc[root] != 0 {
+			continue
+		}
+
+		disc[root], low[root] = counter, counter
+		counter++
+		callStack := []frame{{node: root}}
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			node := top.node
+
+			if top.next < len(arcs[node]) {
+				a := arcs[node][top.next]
+				top.next++
+				if top.hasEntry && a.Edge == top.entry {
+					continue
+				}
+				if disc[a.To] == 0 {
+					top.children++
+					edgeStack = append(edgeStack, a.Edge)
+					disc[a.To], low[a.To] = counter, counter
+					counter++
+					callStack = append(callStack, frame{node: a.To, entry: a.Edge, hasEntry: true})
+				} else if disc[a.To] < disc[node] {
+					low[node] = min(low[node], disc[a.To])
+					edgeStack = append(edgeStack, a.Edge)
+				}
+				continue
+			}
+
+			callStack = callStack[:len(callStack)-1]
+			if len(callStack) == 0 {
+				if top.children > 1 {
+					isCut[node] = true
+				}
+				continue
+			}
+
+			parent := callStack[len(callStack)-1].node
+			low[parent] = min(low[parent], low[node])
+			if low[node] > disc[parent] {
+				result.bridges = append(result.bridges, top.entry)
+			}
+			if low[node] >= disc[parent] {
+				if len(callStack) > 1 {
+					isCut[parent] = true
+				}
+				popBlock(top.entry)
+			}
+		}
+	}
+
+	// Nodes without (non-loop) edges form their own trivial blocks
+	for _, key := range sortedNodeKeys(gr) {
+		if !inBlock[key] {
+			result.blocks = append(result.blocks, Block{Nodes: []graph.TKey{key}})
+		}
+		if isCut[key] {
+			result.articulation = append(result.articulation, key)
+		}
+	}
+	slices.Sort(result.bridges)
+	return result
+}
+
+func ArticulationPoints(gr *graph.Graph) []graph.TKey {
+	return findBiconnectivity(gr).articulation
+}
+
+/*
+ * Bridges are returned as edge keys
+ */
+
+func Bridges(gr *graph.Graph) []graph.TKey {
+	return findBiconnectivity(gr).bridges
+}
+
+/*
+ * Biconnected components. Each edge except self-loops belongs to exactly one
+ * block, while articulation points belong to several. Isolated nodes are
+ * returned as blocks without edges.
+ */
+
+func BiconnectedComponents(gr *graph.Graph) []Block {
+	return findBiconnectivity(gr).blocks
+}
+
+/*
+ * Block-cut tree: bipartite forest where blocks and articulation points are
+ * nodes, and every articulation point is connected with blocks it belongs
+ * to. Block i from BiconnectedComponents gets key i+1, articulation points go
+ * after blocks. Second returned value maps articulation point key of the
+ * original graph to its key in the tree.
+ */
+
+func BlockCutTree(gr *graph.Graph) (*graph.Graph, map[graph.TKey]graph.TKey) {
+	bc := findBiconnectivity(gr)
+	tree := graph.MakeGraph()
+
+	for i := range bc.blocks {
+		key := graph.TKey(i + 1)
+		tree.Nodes[key] = graph.MakeNode(key, graph.WithNodeLabel(fmt.Sprintf("Block_%d", key)))
+	}
+
+	cutKeys := make(map[graph.TKey]graph.TKey, len(bc.articulation))
+	for i, cut := range bc.articulation {
+		key := graph.TKey(len(bc.blocks) + i + 1)
+		cutKeys[cut] = key
+		tree.Nodes[key] = graph.MakeNode(key, graph.WithNodeLabel(fmt.Sprintf("Cut_%d", cut)))
+	}
+
+	edgeKey := graph.TKey(1)
+	for i, block := range bc.blocks {
+		for _, node := range block.Nodes {
+			if cutKey, ok := cutKeys[node]; ok {
+				tree.Edges[edgeKey] = graph.MakeEdge(edgeKey, graph.TKey(i+1), cutKey)
+				edgeKey++
+			}
+		}
+	}
+
+	tree.RebuildAdjacencyMap()
+	return tree, cutKeys
+}
@@ -0,0 +1,407 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"cmp"
+	"container/heap"
+	"math"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Centrality measures. Every measure returns a score for every node of graph.
+ * Directed graphs are walked along edge directions, undirected ones in both.
+ * Parallel edges count as different edges: they give more shortest paths
+ * and more links for PageRank and friends.
+ *
+ * Measures based on distances take weighted flag: if false, every edge has
+ * length 1, otherwise edge weight is used as length.
+ */
+
+// Node keys sorted by score, the highest first; ties are broken by key
+func RankNodes(scores map[graph.TKey]float64) []graph.TKey {
+	keys := make([]graph.TKey, 0, len(scores))
+	for key := range scores {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b graph.TKey) int {
+		if c := cmp.Compare(scores[b], scores[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+	return keys
+}
+
+// 1 / (n - 1), the usual normalization of centralities
+func centralityScale(n int) float64 {
+	if n <= 1 {
+		return 0
+	}
+	return 1 / float64(n-1)
+}
+
+/*
+ * Degree centrality is node degree divided by n - 1. For directed graphs
+ * both in- and out-edges are counted, so the score may be above 1.
+ */
+
+func DegreeCentrality(gr *graph.Graph) map[graph.TKey]float64 {
+	ig := makeIndexedGraph(gr)
+	scale := centralityScale(len(ig.keys))
+	scores := make([]float64, len(ig.keys))
+	for i := range ig.keys {
+		degree := len(ig.out[i])
+		if gr.Options.IsDirected {
+			degree += len(ig.in[i])
+		}
+		scores[i] = float64(degree) * scale
+	}
+	return ig.toKeyMap(scores)
+}
+
+/*
+ * Closeness centrality: how close node is to all nodes it can reach. For
+ * disconnected graphs the Wasserman-Faust variant is used:
+ *
+ *   C(v) = (r - 1) / sum(d(v, u)) * (r - 1) / (n - 1)
+ *
+ * where r is the number of nodes reachable from v (including v). So nodes of
+ * small components do not get high scores just for being close to few nodes.
+ */
+
+func ClosenessCentrality(gr *graph.Graph, weighted bool) map[graph.TKey]float64 {
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	scores := make([]float64, n)
+	dist := make([]int64, n)
+	for v := range n {
+		ig.distances(v, weighted, dist)
+		var sum float64
+		reached := 0
+		for _, d := range dist {
+			if d > 0 {
+				sum += float64(d)
+				reached++
+			}
+		}
+		if sum > 0 {
+			scores[v] = float64(reached) / sum * float64(reached) * centralityScale(n)
+		}
+	}
+	return ig.toKeyMap(scores)
+}
+
+/*
+ * Harmonic centrality: sum of 1 / d(v, u) over all other nodes, divided by
+ * n - 1. Unreachable nodes just add 0, so no special care of disconnected
+ * graphs is needed. Nodes at zero distance (by zero-weight edges) are skipped.
+ */
+
+func HarmonicCentrality(gr *graph.Graph, weighted bool) map[graph.TKey]float64 {
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	scores := make([]float64, n)
+	dist := make([]int64, n)
+	for v := range n {
+		ig.distances(v, weighted, dist)
+		for _, d := range dist {
+			if d > 0 {
+				scores[v] += 1 / float64(d)
+			}
+		}
+		scores[v] *= centralityScale(n)
+	}
+	return ig.toKeyMap(scores)
+}
+
+/*
+ * Betweenness centrality by Brandes: for every node, the sum over pairs (s, t)
+ * of the share of shortest s-t paths going through it. One BFS (or Dijkstra)
+ * per source builds shortest path DAG, then dependencies are accumulated in
+ * reverse order of distance. O(VE) unweighted, O(VE log V) weighted.
+ *
+ * Scores are not normalized. For undirected graphs each pair is counted once.
+ */
+
+func BetweennessCentrality(gr *graph.Graph, weighted bool) map[graph.TKey]float64 {
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	scores := make([]float64, n)
+
+	dist := make([]int64, n)
+	sigma := make([]float64, n) // number of shortest paths from source
+	delta := make([]float64, n) // dependency of source on node
+	pred := make([][]int, n)
+	done := make([]bool, n)
+	order := make([]int, 0, n) // nodes by non-decreasing distance
+
+	for s := range n {
+		for i := range n {
+			dist[i], sigma[i], delta[i], done[i] = -1, 0, 0, false
+			pred[i] = pred[i][:0]
+		}
+		order = order[:0]
+		dist[s], sigma[s] = 0, 1
+
+		if weighted {
+			queue := &distHeap{{node: s, dist: 0}}
+			for queue.Len() > 0 {
+				item := heap.Pop(queue).(distItem)
+				u := item.node
+				if done[u] || item.dist > dist[u] {
+					continue
+				}
+				done[u] = true
+				order = append(order, u)
+				for _, a := range ig.out[u] {
+					next := dist[u] + int64(a.weight)
+					switch {
+					case dist[a.to] == -1 || next < dist[a.to]:
+						dist[a.to], sigma[a.to] = next, sigma[u]
+						pred[a.to] = append(pred[a.to][:0], u)
+						heap.Push(queue, distItem{node: a.to, dist: next})
+					case next == dist[a.to] && !done[a.to]:
+						sigma[a.to] += sigma[u]
+						pred[a.to] = append(pred[a.to], u)
+					}
+				}
+			}
+		} else {
+			order = append(order, s)
+			for head := 0; head < len(order); head++ {
+				u := order[head]
+				for _, a := range ig.out[u] {
+					if dist[a.to] == -1 {
+						dist[a.to] = dist[u] + 1
+						order = append(order, a.to)
+					}
+					if dist[a.to] == dist[u]+1 {
+						sigma[a.to] += sigma[u]
+						pred[a.to] = append(pred[a.to], u)
+					}
+				}
+			}
+		}
+
+		for i := len(order) - 1; i > 0; i-- {
+			w := order[i]
+			for _, v := range pred[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			scores[w] += delta[w]
+		}
+	}
+
+	if !gr.Options.IsDirected {
+		for i := range scores {
+			scores[i] /= 2
+		}
+	}
+	return ig.toKeyMap(scores)
+}
+
+/*
+ * Iterative measures (PageRank, eigenvector, HITS) run power iteration until
+ * scores change by less than n * Tolerance in total, or MaxIterations is
+ * reached; then the last approximation is returned. Damping is used only by
+ * PageRank: probability to follow a link instead of jumping to random node.
+ */
+
+type CentralityOptions struct {
+	Damping       float64
+	Tolerance     float64
+	MaxIterations int
+}
+
+func makeCentralityOptions(options ...graph.Option[CentralityOptions]) CentralityOptions {
+	opts := CentralityOptions{Damping: 0.85, Tolerance: 1e-6, MaxIterations: 100}
+	for _, option := range options {
+		option(&opts)
+	}
+	return opts
+}
+
+func WithDamping(damping float64) graph.Option[CentralityOptions] {
+	return func(opts *CentralityOptions) {
+		opts.Damping = damping
+	}
+}
+
+func WithTolerance(tolerance float64) graph.Option[CentralityOptions] {
+	return func(opts *CentralityOptions) {
+		opts.Tolerance = tolerance
+	}
+}
+
+func WithMaxIterations(iterations int) graph.Option[CentralityOptions] {
+	return func(opts *CentralityOptions) {
+		opts.MaxIterations = iterations
+	}
+}
+
+func uniformScores(n int) []float64 {
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1 / float64(n)
+	}
+	return scores
+}
+
+func scoresChange(old, new []float64) float64 {
+	var change float64
+	for i := range old {
+		change += math.Abs(new[i] - old[i])
+	}
+	return change
+}
+
+// Scales scores so that their sum (or Euclidean norm) is 1
+func normalizeScores(scores []float64, euclidean bool) {
+	var norm float64
+	for _, score := range scores {
+		if euclidean {
+			norm += score * score
+		} else {
+			norm += math.Abs(score)
+		}
+	}
+	if euclidean {
+		norm = math.Sqrt(norm)
+	}
+	if norm == 0 {
+		return
+	}
+	for i := range scores {
+		scores[i] /= norm
+	}
+}
+
+/*
+ * PageRank: stationary distribution of random surfer who follows a random
+ * out-edge with probability Damping, and jumps to a random node otherwise.
+ * Surfer at node without out-edges always jumps. Scores sum up to 1.
+ */
+
+func PageRank(gr *graph.Graph, options ...graph.Option[CentralityOptions]) map[graph.TKey]float64 {
+	opts := makeCentralityOptions(options...)
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	if n == 0 {
+		return map[graph.TKey]float64{}
+	}
+
+	rank := uniformScores(n)
+	next := make([]float64, n)
+	for range opts.MaxIterations {
+		var dangling float64
+		for u := range n {
+			if len(ig.out[u]) == 0 {
+				dangling += rank[u]
+			}
+		}
+		base := (1-opts.Damping)/float64(n) + opts.Damping*dangling/float64(n)
+		for v := range n {
+			next[v] = base
+		}
+		for u := range n {
+			if len(ig.out[u]) == 0 {
+				continue
+			}
+			share := opts.Damping * rank[u] / float64(len(ig.out[u]))
+			for _, a := range ig.out[u] {
+				next[a.to] += share
+			}
+		}
+
+		change := scoresChange(rank, next)
+		rank, next = next, rank
+		if change < float64(n)*opts.Tolerance {
+			break
+		}
+	}
+	return ig.toKeyMap(rank)
+}
+
+/*
+ * Eigenvector centrality: node is important if important nodes link to it,
+ * i.e. scores are the principal eigenvector of transposed adjacency matrix.
+ * Power iteration runs on A + I, which has the same eigenvectors but does
+ * not oscillate on bipartite graphs. Scores have Euclidean norm 1.
+ */
+
+func EigenvectorCentrality(gr *graph.Graph, options ...graph.Option[CentralityOptions]) map[graph.TKey]float64 {
+	opts := makeCentralityOptions(options...)
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	if n == 0 {
+		return map[graph.TKey]float64{}
+	}
+
+	scores := uniformScores(n)
+	next := make([]float64, n)
+	for range opts.MaxIterations {
+		copy(next, scores)
+		for v := range n {
+			for _, a := range ig.in[v] {
+				next[v] += scores[a.to]
+			}
+		}
+		normalizeScores(next, true)
+
+		change := scoresChange(scores, next)
+		scores, next = next, scores
+		if change < float64(n)*opts.Tolerance {
+			break
+		}
+	}
+	return ig.toKeyMap(scores)
+}
+
+/*
+ * HITS by Kleinberg: good authority is linked by good hubs, and good hub links
+ * to good authorities. Returns hub and authority scores, each summing up to 1.
+ * For undirected graphs both are the same.
+ */
+
+func HITS(gr *graph.Graph, options ...graph.Option[CentralityOptions]) (map[graph.TKey]float64, map[graph.TKey]float64) {
+	opts := makeCentralityOptions(options...)
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	if n == 0 {
+		return map[graph.TKey]float64{}, map[graph.TKey]float64{}
+	}
+
+	hubs := uniformScores(n)
+	authorities := make([]float64, n)
+	next := make([]float64, n)
+	for range opts.MaxIterations {
+		for v := range n {
+			authorities[v] = 0
+			for _, a := range ig.in[v] {
+				authorities[v] += hubs[a.to]
+			}
+		}
+		normalizeScores(authorities, false)
+
+		for u := range n {
+			next[u] = 0
+			for _, a := range ig.out[u] {
+				next[u] += authorities[a.to]
+			}
+		}
+		normalizeScores(next, false)
+
+		change := scoresChange(hubs, next)
+		hubs, next = next, hubs
+		if change < float64(n)*opts.Tolerance {
+			break
+		}
+	}
+	return ig.toKeyMap(hubs), ig.toKeyMap(authorities)
+}
@@ -0,0 +1,259 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Cliques, independent sets and vertex covers. All of them are defined for
+ * simple undirected graphs, so edge directions, parallel edges and
+ * self-loops are ignored.
+ *
+ * Inside, nodes are dense indices and neighbourhoods are sets of indices,
+ * which keeps set intersections in Bron-Kerbosch cheap.
+ */
+
+type simpleGraph struct {
+	keys []graph.TKey
+	adj  []map[int]bool
+}
+
+func makeSimpleGraph(gr *graph.Graph) *simpleGraph {
+	sg := &simpleGraph{keys: sortedNodeKeys(gr)}
+	index := make(map[graph.TKey]int, len(sg.keys))
+	for i, key := range sg.keys {
+		index[key] = i
+	}
+
+	neighbours := simpleNeighbours(gr)
+	sg.adj = make([]map[int]bool, len(sg.keys))
+	for i, key := range sg.keys {
+		sg.adj[i] = make(map[int]bool, len(neighbours[key]))
+		for _, other := range neighbours[key] {
+			sg.adj[i][index[other]] = true
+		}
+	}
+	return sg
+}
+
+func (sg *simpleGraph) complement() *simpleGraph {
+	comp := &simpleGraph{keys: sg.keys, adj: make([]map[int]bool, len(sg.keys))}
+	for i := range sg.keys {
+		comp.adj[i] = make(map[int]bool)
+		for j := range sg.keys {
+			if i != j && !sg.adj[i][j] {
+				comp.adj[i][j] = true
+			}
+		}
+	}
+	return comp
+}
+
+func (sg *simpleGraph) toKeys(nodes []int) []graph.TKey {
+	keys := make([]graph.TKey, len(nodes))
+	for i, node := range nodes {
+		keys[i] = sg.keys[node]
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+/*
+ * Bron-Kerbosch with pivoting (Tomita). R is the current clique, P are
+ * candidates to extend it, X are nodes already tried. Pivot is a node of P
+ * or X with the most neighbours in P: only its non-neighbours need branching.
+ * visit is called for every maximal clique, and bound tells whether a branch
+ * able to grow clique up to given size is worth searching.
+ */
+
+func (sg *simpleGraph) bronKerbosch(r, p, x []int, visit func(clique []int), bound func(size int) bool) {
+	if len(p) == 0 && len(x) == 0 {
+		visit(r)
+		return
+	}
+	if !bound(len(r) + len(p)) {
+		return
+	}
+
+	pivot, most := -1, -1
+	for _, candidates := range [][]int{p, x} {
+		for _, u := range candidates {
+			count := 0
+			for _, v := range p {
+				if sg.adj[u][v] {
+					count++
+				}
+			}
+			if count > most {
+				pivot, most = u, count
+			}
+		}
+	}
+
+	for _, v := range slices.Clone(p) {
+		if sg.adj[pivot][v] {
+			continue
+		}
+
+		nextP, nextX := []int{}, []int{}
+		for _, u := range p {
+			if sg.adj[v][u] {
+				nextP = append(nextP, u)
+			}
+		}
+		for _, u := range x {
+			if sg.adj[v][u] {
+				nextX = append(nextX, u)
+			}
+		}
+		sg.bronKerbosch(append(slices.Clone(r), v), nextP, nextX, visit, bound)
+
+		p = slices.DeleteFunc(p, func(u int) bool { return u == v })
+		x = append(x, v)
+	}
+}
+
+func (sg *simpleGraph) allNodes() []int {
+	nodes := make([]int, len(sg.keys))
+	for i := range nodes {
+		nodes[i] = i
+	}
+	return nodes
+}
+
+func (sg *simpleGraph) maximumClique() []int {
+	best := []int{}
+	sg.bronKerbosch(nil, sg.allNodes(), nil,
+		func(clique []int) {
+			if len(clique) > len(best) {
+				best = slices.Clone(clique)
+			}
+		},
+		func(size int) bool { return size > len(best) })
+	return best
+}
+
+/*
+ * All maximal cliques. Number of them may be exponential, so do not call it
+ * on big dense graphs. Cliques are sorted by size, biggest first.
+ */
+
+func MaximalCliques(gr *graph.Graph) [][]graph.TKey {
+	sg := makeSimpleGraph(gr)
+	cliques := [][]graph.TKey{}
+	sg.bronKerbosch(nil, sg.allNodes(), nil,
+		func(clique []int) {
+			cliques = append(cliques, sg.toKeys(clique))
+		},
+		func(int) bool { return true })
+
+	slices.SortStableFunc(cliques, func(a, b []graph.TKey) int {
+		if len(a) != len(b) {
+			return len(b) - len(a)
+		}
+		return slices.Compare(a, b)
+	})
+	return cliques
+}
+
+/*
+ * Maximum clique by exact search, which is exponential, so graph is limited
+ * by ExactCoverNodesLimit like exact independent set below.
+ */
+
+func MaximumClique(gr *graph.Graph) ([]graph.TKey, error) {
+	if len(gr.Nodes) > ExactCoverNodesLimit {
+		return nil, graph.ThrowTooManyNodes(len(gr.Nodes), ExactCoverNodesLimit)
+	}
+	sg := makeSimpleGraph(gr)
+	return sg.toKeys(sg.maximumClique()), nil
+}
+
+/*
+ * Maximum independent set is the maximum clique of complement graph, and
+ * minimum vertex cover is the complement of maximum independent set. Exact
+ * search is exponential, so for graphs bigger than ExactCoverNodesLimit
+ * approximations are used, and the second returned value is false:
+ *
+ *   - vertex cover takes both ends of every edge of a maximal matching, which
+ *     is at most twice bigger than the optimum;
+ *   - independent set is built greedily, taking node of minimum remaining
+ *     degree each time.
+ */
+
+const ExactCoverNodesLimit = 50
+
+func MaximumIndependentSet(gr *graph.Graph) ([]graph.TKey, bool) {
+	sg := makeSimpleGraph(gr)
+	if len(sg.keys) <= ExactCoverNodesLimit {
+		return sg.toKeys(sg.complement().maximumClique()), true
+	}
+
+	removed := make([]bool, len(sg.keys))
+	degree := make([]int, len(sg.keys))
+	for i := range sg.keys {
+		degree[i] = len(sg.adj[i])
+	}
+	set := []int{}
+	for {
+		best := -1
+		for i := range sg.keys {
+			if !removed[i] && (best == -1 || degree[i] < degree[best]) {
+				best = i
+			}
+		}
+		if best == -1 {
+			break
+		}
+
+		set = append(set, best)
+		removed[best] = true
+		for v := range sg.adj[best] {
+			if removed[v] {
+				continue
+			}
+			removed[v] = true
+			for u := range sg.adj[v] {
+				degree[u]--
+			}
+		}
+	}
+	return sg.toKeys(set), false
+}
+
+func MinimumVertexCover(gr *graph.Graph) ([]graph.TKey, bool) {
+	if len(gr.Nodes) <= ExactCoverNodesLimit {
+		independent, _ := MaximumIndependentSet(gr)
+		inSet := make(map[graph.TKey]bool, len(independent))
+		for _, key := range independent {
+			inSet[key] = true
+		}
+		cover := []graph.TKey{}
+		for _, key := range sortedNodeKeys(gr) {
+			if !inSet[key] {
+				cover = append(cover, key)
+			}
+		}
+		return cover, true
+	}
+
+	covered := make(map[graph.TKey]bool)
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		if edge.Source != edge.Destination && !covered[edge.Source] && !covered[edge.Destination] {
+			covered[edge.Source], covered[edge.Destination] = true, true
+		}
+	}
+	cover := make([]graph.TKey, 0, len(covered))
+	for key := range covered {
+		cover = append(cover, key)
+	}
+	slices.Sort(cover)
+	return cover, false
+}
@@ -0,0 +1,244 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Triangles, clustering and cores. All of them are defined for simple
+ * undirected graphs, so edge directions, parallel edges and self-loops are
+ * ignored, just like for cliques.
+ */
+
+// Simple neighbours by dense index, keys are sorted
+func simpleIndexedNeighbours(gr *graph.Graph) ([]graph.TKey, [][]int) {
+	keys := sortedNodeKeys(gr)
+	index := make(map[graph.TKey]int, len(keys))
+	for i, key := range keys {
+		index[key] = i
+	}
+
+	neighbours := simpleNeighbours(gr)
+	adj := make([][]int, len(keys))
+	for i, key := range keys {
+		adj[i] = make([]int, len(neighbours[key]))
+		for j, other := range neighbours[key] {
+			adj[i][j] = index[other]
+		}
+	}
+	return keys, adj
+}
+
+/*
+ * Number of triangles every node belongs to. Nodes are ranked by degree, and
+ * each triangle is found once from its lowest-ranked node, looking only at
+ * neighbours of higher rank. This is O(E^1.5), fine for graphs with tens of
+ * thousands of edges.
+ */
+
+func Triangles(gr *graph.Graph) map[graph.TKey]int {
+	keys, adj := simpleIndexedNeighbours(gr)
+	counts := countTriangles(adj)
+
+	result := make(map[graph.TKey]int, len(keys))
+	for i, key := range keys {
+		result[key] = counts[i]
+	}
+	return result
+}
+
+func countTriangles(adj [][]int) []int {
+	n := len(adj)
+	rank := make([]int, n)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return cmp.Compare(len(adj[a]), len(adj[b])) })
+	for r, node := range order {
+		rank[node] = r
+	}
+
+	forward := make([][]int, n)
+	for u := range n {
+		for _, v := range adj[u] {
+			if rank[v] > rank[u] {
+				forward[u] = append(forward[u], v)
+			}
+		}
+	}
+
+	counts := make([]int, n)
+	marked := make([]bool, n)
+	for u := range n {
+		for _, v := range forward[u] {
+			marked[v] = true
+		}
+		for _, v := range forward[u] {
+			for _, w := range forward[v] {
+				if marked[w] {
+					counts[u]++
+					counts[v]++
+					counts[w]++
+				}
+			}
+		}
+		for _, v := range forward[u] {
+			marked[v] = false
+		}
+	}
+	return counts
+}
+
+func TriangleCount(gr *graph.Graph) int {
+	total := 0
+	for _, count := range Triangles(gr) {
+		total += count
+	}
+	return total / 3
+}
+
+/*
+ * Local clustering coefficient of node: share of pairs of its neighbours
+ * that are connected, 2T / (d (d - 1)). Nodes with less than two neighbours
+ * get 0.
+ */
+
+func LocalClustering(gr *graph.Graph) map[graph.TKey]float64 {
+	keys, adj := simpleIndexedNeighbours(gr)
+	counts := countTriangles(adj)
+
+	result := make(map[graph.TKey]float64, len(keys))
+	for i, key := range keys {
+		if d := len(adj[i]); d >= 2 {
+			result[key] = 2 * float64(counts[i]) / float64(d*(d-1))
+		} else {
+			result[key] = 0
+		}
+	}
+	return result
+}
+
+// Global clustering by Watts and Strogatz: mean of local coefficients
+func AverageClustering(gr *graph.Graph) float64 {
+	local := LocalClustering(gr)
+	if len(local) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, key := range sortedNodeKeys(gr) {
+		sum += local[key]
+	}
+	return sum / float64(len(local))
+}
+
+/*
+ * Transitivity: share of connected triples (paths of two edges) that are
+ * closed into triangles, 3 * triangles / triples. Unlike average clustering,
+ * high-degree nodes weigh more here.
+ */
+
+func Transitivity(gr *graph.Graph) float64 {
+	_, adj := simpleIndexedNeighbours(gr)
+	counts := countTriangles(adj)
+
+	triangles, triples := 0, 0
+	for i := range adj {
+		triangles += counts[i]
+		triples += len(adj[i]) * (len(adj[i]) - 1) / 2
+	}
+	if triples == 0 {
+		return 0
+	}
+	return float64(triangles) / float64(triples)
+}
+
+/*
+ * Core number of node is the biggest k such that node belongs to k-core: the
+ * maximal subgraph where every node has degree at least k. Computed by
+ * Batagelj-Zaversnik: nodes are removed in order of current degree, kept in
+ * buckets, so it is O(V + E).
+ */
+
+func CoreNumbers(gr *graph.Graph) map[graph.TKey]int {
+	keys, adj := simpleIndexedNeighbours(gr)
+	n := len(keys)
+
+	degree := make([]int, n)
+	maxDegree := 0
+	for i := range n {
+		degree[i] = len(adj[i])
+		maxDegree = max(maxDegree, degree[i])
+	}
+
+	// Nodes sorted by degree; start[d] is the first position of degree d
+	start := make([]int, maxDegree+2)
+	for _, d := range degree {
+		start[d+1]++
+	}
+	for d := 1; d < len(start); d++ {
+		start[d] += start[d-1]
+	}
+	order, position := make([]int, n), make([]int, n)
+	next := slices.Clone(start)
+	for i, d := range degree {
+		position[i] = next[d]
+		order[position[i]] = i
+		next[d]++
+	}
+
+	for i := range n {
+		u := order[i]
+		for _, v := range adj[u] {
+			if degree[v] <= degree[u] {
+				continue
+			}
+			// Swap v with the first node of its bucket and shrink the bucket
+			dv := degree[v]
+			first := order[start[dv]]
+			if first != v {
+				order[position[v]], order[start[dv]] = first, v
+				position[first], position[v] = position[v], start[dv]
+			}
+			start[dv]++
+			degree[v]--
+		}
+	}
+
+	result := make(map[graph.TKey]int, n)
+	for i, key := range keys {
+		result[key] = degree[i]
+	}
+	return result
+}
+
+/*
+ * k-core as a new graph: copy of gr without nodes having core number less
+ * than k. All edges between remaining nodes are kept with their keys, so
+ * parallel edges and self-loops stay too.
+ */
+
+func KCore(gr *graph.Graph, k int) *graph.Graph {
+	core := CoreNumbers(gr)
+	result := gr.Copy()
+	for key := range gr.Nodes {
+		if core[key] < k {
+			delete(result.Nodes, key)
+		}
+	}
+	for key, edge := range gr.Edges {
+		if core[edge.Source] < k || core[edge.Destination] < k {
+			delete(result.Edges, key)
+		}
+	}
+	result.RebuildAdjacencyMap()
+	return result
+}
@@ -0,0 +1,275 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"container/heap"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Graph colouring: adjacent nodes must get different colours. Colouring
+ * ignores edge directions and parallel edges, but node with self-loop cannot
+ * be coloured at all, so such graphs give an error.
+ *
+ * Colours are numbered from 1, so the biggest colour is the number of
+ * colours used. To keep colouring in graph, use WriteNodeAttribute.
+ */
+
+type ColoringOrder int
+
+const (
+	OrderNatural      ColoringOrder = iota // by node key
+	OrderLargestFirst                      // by degree, descending
+	OrderSmallestLast                      // reversed order of removing min-degree nodes
+)
+
+func ColorCount(colors map[graph.TKey]int) int {
+	count := 0
+	for _, color := range colors {
+		count = max(count, color)
+	}
+	return count
+}
+
+func checkNoLoops(gr *graph.Graph) error {
+	for _, key := range sortedEdgeKeys(gr) {
+		if edge := gr.Edges[key]; edge.Source == edge.Destination {
+			return graph.ThrowGraphHasLoop(edge.Source)
+		}
+	}
+	return nil
+}
+
+// The smallest colour not used by already coloured neighbours
+func smallestFreeColor(neighbours []graph.TKey, colors map[graph.TKey]int) int {
+	used := make(map[int]bool, len(neighbours))
+	for _, other := range neighbours {
+		used[colors[other]] = true
+	}
+	color := 1
+	for used[color] {
+		color++
+	}
+	return color
+}
+
+/*
+ * Greedy colouring: nodes are taken in given order and each gets the
+ * smallest colour free among its neighbours. Never uses more than max
+ * degree + 1 colours; smallest-last order uses at most degeneracy + 1.
+ */
+
+func GreedyColoring(gr *graph.Graph, order ColoringOrder) (map[graph.TKey]int, error) {
+	if err := checkNoLoops(gr); err != nil {
+		return nil, err
+	}
+
+	neighbours := simpleNeighbours(gr)
+	keys := sortedNodeKeys(gr)
+	switch order {
+	case OrderLargestFirst:
+		slices.SortStableFunc(keys, func(a, b graph.TKey) int {
+			return len(neighbours[b]) - len(neighbours[a])
+		})
+	case OrderSmallestLast:
+		keys = smallestLastOrder(keys, neighbours)
+	}
+
+	colors := make(map[graph.TKey]int, len(keys))
+	for _, key := range keys {
+		colors[key] = smallestFreeColor(neighbours[key], colors)
+	}
+	return colors, nil
+}
+
+func smallestLastOrder(keys []graph.TKey, neighbours map[graph.TKey][]graph.TKey) []graph.TKey {
+	degree := make(map[graph.TKey]int, len(keys))
+	queue := &keyDistHeap{}
+	for _, key := range keys {
+		degree[key] = len(neighbours[key])
+		heap.Push(queue, keyDist{key: key, dist: graph.TWeight(degree[key])})
+	}
+
+	removed := make(map[graph.TKey]bool, len(keys))
+	order := make([]graph.TKey, 0, len(keys))
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(keyDist)
+		if removed[item.key] || graph.TWeight(degree[item.key]) != item.dist {
+			continue
+		}
+		removed[item.key] = true
+		order = append(order, item.key)
+		for _, other := range neighbours[item.key] {
+			if !removed[other] {
+				degree[other]--
+				heap.Push(queue, keyDist{key: other, dist: graph.TWeight(degree[other])})
+			}
+		}
+	}
+
+	slices.Reverse(order)
+	return order
+}
+
+/*
+ * DSatur: next node is the one with the most distinct colours among its
+ * neighbours (saturation), ties are broken by degree and then by key
+ */
+
+func DSaturColoring(gr *graph.Graph) (map[graph.TKey]int, error) {
+	if err := checkNoLoops(gr); err != nil {
+		return nil, err
+	}
+
+	neighbours := simpleNeighbours(gr)
+	colors := make(map[graph.TKey]int, len(gr.Nodes))
+	seen := make(map[graph.TKey]map[int]bool, len(gr.Nodes))
+	queue := &saturationHeap{}
+	for _, key := range sortedNodeKeys(gr) {
+		seen[key] = make(map[int]bool)
+		heap.Push(queue, saturationItem{key: key, degree: len(neighbours[key])})
+	}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(saturationItem)
+		if colors[item.key] != 0 || item.saturation != len(seen[item.key]) {
+			continue
+		}
+
+		color := smallestFreeColor(neighbours[item.key], colors)
+		colors[item.key] = color
+		for _, other := range neighbours[item.key] {
+			if colors[other] == 0 && !seen[other][color] {
+				seen[other][color] = true
+				heap.Push(queue, saturationItem{key: other, saturation: len(seen[other]), degree: len(neighbours[other])})
+			}
+		}
+	}
+	return colors, nil
+}
+
+type saturationItem struct {
+	key        graph.TKey
+	saturation int
+	degree     int
+}
+
+type saturationHeap []saturationItem
+
+func (h saturationHeap) Len() int { return len(h) }
+func (h saturationHeap) Less(i, j int) bool {
+	if h[i].saturation != h[j].saturation {
+		return h[i].saturation > h[j].saturation
+	}
+	if h[i].degree != h[j].degree {
+		return h[i].degree > h[j].degree
+	}
+	return h[i].key < h[j].key
+}
+func (h saturationHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *saturationHeap) Push(x any)   { *h = append(*h, x.(saturationItem)) }
+func (h *saturationHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+/*
+ * Exact chromatic number by branch and bound. DSatur gives the first upper
+ * bound, then backtracking tries to colour nodes with fewer colours.
+ * Exponential, so limited by ChromaticNodesLimit nodes. Returns the number
+ * and an optimal colouring.
+ */
+
+const ChromaticNodesLimit = 40
+
+func ChromaticNumber(gr *graph.Graph) (int, map[graph.TKey]int, error) {
+	if len(gr.Nodes) > ChromaticNodesLimit {
+		return 0, nil, graph.ThrowTooManyNodes(len(gr.Nodes), ChromaticNodesLimit)
+	}
+
+	best, err := DSaturColoring(gr)
+	if err != nil {
+		return 0, nil, err
+	}
+	bestCount := ColorCount(best)
+
+	// Branch on high-degree nodes first, they are the most constrained
+	neighbours := simpleNeighbours(gr)
+	order := sortedNodeKeys(gr)
+	slices.SortStableFunc(order, func(a, b graph.TKey) int {
+		return len(neighbours[b]) - len(neighbours[a])
+	})
+	colors := make(map[graph.TKey]int, len(order))
+
+	var search func(i, used int)
+	search = func(i, used int) {
+		if used >= bestCount {
+			return
+		}
+		if i == len(order) {
+			bestCount = used
+			best = make(map[graph.TKey]int, len(colors))
+			for key, color := range colors {
+				best[key] = color
+			}
+			return
+		}
+
+		node := order[i]
+		forbidden := make(map[int]bool)
+		for _, other := range neighbours[node] {
+			forbidden[colors[other]] = true
+		}
+		// New colour only as the next one, this removes symmetric branches
+		for color := 1; color <= used+1 && color < bestCount; color++ {
+			if forbidden[color] {
+				continue
+			}
+			colors[node] = color
+			search(i+1, max(used, color))
+			delete(colors, node)
+		}
+	}
+	search(0, 0)
+
+	return bestCount, best, nil
+}
+
+/*
+ * Greedy edge colouring: edges sharing an end must get different colours.
+ * Works with multigraphs, parallel edges get different colours. Edges are
+ * taken by key, so at most 2 * max degree - 1 colours are used. Returns
+ * colours by edge key.
+ */
+
+func EdgeColoring(gr *graph.Graph) (map[graph.TKey]int, error) {
+	if err := checkNoLoops(gr); err != nil {
+		return nil, err
+	}
+
+	used := make(map[graph.TKey]map[int]bool, len(gr.Nodes))
+	colors := make(map[graph.TKey]int, len(gr.Edges))
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		for _, end := range []graph.TKey{edge.Source, edge.Destination} {
+			if used[end] == nil {
+				used[end] = make(map[int]bool)
+			}
+		}
+
+		color := 1
+		for used[edge.Source][color] || used[edge.Destination][color] {
+			color++
+		}
+		colors[key] = color
+		used[edge.Source][color], used[edge.Destination][color] = true, true
+	}
+	return colors, nil
+}
@@ -0,0 +1,472 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Community detection. Like components, communities are returned as
+ * membership maps with numbers from 1, ordered by the smallest node key, so
+ * GroupComponents and WriteNodeAttribute work for them too.
+ *
+ * Communities are searched in the underlying undirected graph. By default
+ * every edge counts as 1; with WithWeighted(true) edge weights are used
+ * instead, so parallel edges and heavy edges pull nodes together stronger.
+ */
+
+type CommunityOptions struct {
+	Weighted   bool
+	Resolution float64 // bigger resolution gives more and smaller communities
+	Seed       uint64  // for label propagation, which is randomized
+}
+
+func makeCommunityOptions(options ...graph.Option[CommunityOptions]) CommunityOptions {
+	opts := CommunityOptions{Resolution: 1}
+	for _, option := range options {
+		option(&opts)
+	}
+	return opts
+}
+
+func WithWeighted(weighted bool) graph.Option[CommunityOptions] {
+	return func(opts *CommunityOptions) {
+		opts.Weighted = weighted
+	}
+}
+
+func WithResolution(resolution float64) graph.Option[CommunityOptions] {
+	return func(opts *CommunityOptions) {
+		opts.Resolution = resolution
+	}
+}
+
+func WithSeed(seed uint64) graph.Option[CommunityOptions] {
+	return func(opts *CommunityOptions) {
+		opts.Seed = seed
+	}
+}
+
+func (opts CommunityOptions) weight(w graph.TWeight) float64 {
+	if opts.Weighted {
+		return float64(w)
+	}
+	return 1
+}
+
+// Membership map from per-node labels, renumbered from 1 by the smallest key
+func renumberPartition(keys []graph.TKey, labels []int) map[graph.TKey]int {
+	numbers := make(map[int]int)
+	partition := make(map[graph.TKey]int, len(keys))
+	for i, key := range keys {
+		if _, ok := numbers[labels[i]]; !ok {
+			numbers[labels[i]] = len(numbers) + 1
+		}
+		partition[key] = numbers[labels[i]]
+	}
+	return partition
+}
+
+/*
+ * Newman's modularity of partition: share of edge weight inside communities
+ * minus the share expected if edges were placed at random with the same
+ * degrees.
+ *
+ *   Q = sum over communities c of (L_c / m - resolution * (K_c / 2m)^2)
+ *
+ * L_c is the weight of edges inside c, K_c is the sum of degrees of nodes of
+ * c, m is the weight of all edges. Self-loop adds 2 to the degree. Graph
+ * without edges has zero modularity.
+ */
+
+func Modularity(gr *graph.Graph, partition map[graph.TKey]int, options ...graph.Option[CommunityOptions]) float64 {
+	opts := makeCommunityOptions(options...)
+
+	var total float64
+	inside, degree := make(map[int]float64), make(map[int]float64)
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		w := opts.weight(edge.Weight)
+		src, dst := partition[edge.Source], partition[edge.Destination]
+		total += w
+		degree[src] += w
+		degree[dst] += w
+		if src == dst {
+			inside[src] += w
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+
+	communities := make([]int, 0, len(degree))
+	for c := range degree {
+		communities = append(communities, c)
+	}
+	slices.Sort(communities)
+
+	var q float64
+	for _, c := range communities {
+		share := degree[c] / (2 * total)
+		q += inside[c]/total - opts.Resolution*share*share
+	}
+	return q
+}
+
+/*
+ * Louvain method. Phase one moves nodes one by one to the neighbouring
+ * community giving the biggest modularity gain, until no move helps. Phase
+ * two collapses every community into a single node (inner edges become its
+ * self-loop) and repeats phase one on the smaller graph. Stops when phase one
+ * moves nothing. Fast in practice, about O(E log V).
+ */
+
+type weightedLink struct {
+	to     int
+	weight float64
+}
+
+// Graph of one Louvain level: parallel edges merged, loops kept apart
+type louvainGraph struct {
+	adj      [][]weightedLink
+	loops    []float64
+	strength []float64 // weighted degree, loop counts twice
+}
+
+func makeLouvainGraph(adj []map[int]float64, loops []float64) *louvainGraph {
+	lg := &louvainGraph{adj: make([][]weightedLink, len(adj)), loops: loops, strength: make([]float64, len(adj))}
+	for u, links := range adj {
+		lg.strength[u] = 2 * loops[u]
+		for v, w := range links {
+			lg.adj[u] = append(lg.adj[u], weightedLink{to: v, weight: w})
+			lg.strength[u] += w
+		}
+		slices.SortFunc(lg.adj[u], func(a, b weightedLink) int { return a.to - b.to })
+	}
+	return lg
+}
+
+// Phase one. Returns community of every node and whether anything moved
+func (lg *louvainGraph) moveNodes(total, resolution float64) ([]int, bool) {
+	n := len(lg.adj)
+	community, tot := make([]int, n), make([]float64, n)
+	for i := range n {
+		community[i], tot[i] = i, lg.strength[i]
+	}
+
+	links := make([]float64, n)
+	touched, isTouched := []int{}, make([]bool, n)
+	moved := false
+	for changed := true; changed; {
+		changed = false
+		for i := range n {
+			own := community[i]
+			for _, link := range lg.adj[i] {
+				c := community[link.to]
+				if !isTouched[c] {
+					isTouched[c] = true
+					touched = append(touched, c)
+				}
+				links[c] += link.weight
+			}
+
+			// Gain of joining c is links[c] - resolution * tot[c] * k / 2m,
+			// staying is the same as joining own community without node
+			k := lg.strength[i]
+			tot[own] -= k
+			best, bestGain := own, links[own]-resolution*tot[own]*k/(2*total)
+			for _, c := range touched {
+				if gain := links[c] - resolution*tot[c]*k/(2*total); gain > bestGain+1e-12 {
+					best, bestGain = c, gain
+				}
+			}
+			tot[best] += k
+			community[i] = best
+
+			for _, c := range touched {
+				links[c], isTouched[c] = 0, false
+			}
+			touched = touched[:0]
+
+			if best != own {
+				changed, moved = true, true
+			}
+		}
+	}
+	return community, moved
+}
+
+// Phase two. Community numbers must be compacted to 0..count-1
+func (lg *louvainGraph) aggregate(community []int, count int) *louvainGraph {
+	adj, loops := make([]map[int]float64, count), make([]float64, count)
+	for c := range adj {
+		adj[c] = make(map[int]float64)
+	}
+	for u, links := range lg.adj {
+		cu := community[u]
+		loops[cu] += lg.loops[u]
+		for _, link := range links {
+			if cv := community[link.to]; cv == cu {
+				loops[cu] += link.weight / 2 // inner edge is seen from both ends
+			} else {
+				adj[cu][cv] += link.weight
+			}
+		}
+	}
+	return makeLouvainGraph(adj, loops)
+}
+
+// Renumbers labels to 0..count-1 in order of appearance
+func compactLabels(labels []int) ([]int, int) {
+	numbers := make(map[int]int)
+	compact := make([]int, len(labels))
+	for i, label := range labels {
+		if _, ok := numbers[label]; !ok {
+			numbers[label] = len(numbers)
+		}
+		compact[i] = numbers[label]
+	}
+	return compact, len(numbers)
+}
+
+func Louvain(gr *graph.Graph, options ...graph.Option[CommunityOptions]) map[graph.TKey]int {
+	opts := makeCommunityOptions(options...)
+	ig := makeUndirectedIndexedGraph(gr)
+	n := len(ig.keys)
+
+	adj, loops := make([]map[int]float64, n), make([]float64, n)
+	var total float64
+	for u := range n {
+		adj[u] = make(map[int]float64)
+		for _, a := range ig.out[u] {
+			w := opts.weight(a.weight)
+			if a.to == u {
+				loops[u] += w
+				total += w
+			} else {
+				adj[u][a.to] += w
+				total += w / 2
+			}
+		}
+	}
+
+	membership := make([]int, n)
+	for i := range membership {
+		membership[i] = i
+	}
+	if total == 0 {
+		return renumberPartition(ig.keys, membership)
+	}
+
+	lg := makeLouvainGraph(adj, loops)
+	for {
+		community, moved := lg.moveNodes(total, opts.Resolution)
+		if !moved {
+			break
+		}
+		community, count := compactLabels(community)
+		for i := range membership {
+			membership[i] = community[membership[i]]
+		}
+		lg = lg.aggregate(community, count)
+	}
+	return renumberPartition(ig.keys, membership)
+}
+
+/*
+ * Label propagation: every node starts with its own label, then repeatedly
+ * takes the label most common (by weight) among its neighbours, until every
+ * node has one of the most common labels. Nodes are visited in random order
+ * and ties are broken randomly, otherwise one label floods the whole graph;
+ * the same seed gives the same result. Nearly linear, but gives no
+ * guarantees on modularity.
+ */
+
+const LabelPropagationMaxIterations = 100
+
+func LabelPropagation(gr *graph.Graph, options ...graph.Option[CommunityOptions]) map[graph.TKey]int {
+	opts := makeCommunityOptions(options...)
+	random := rand.New(rand.NewPCG(opts.Seed, opts.Seed))
+	ig := makeUndirectedIndexedGraph(gr)
+	n := len(ig.keys)
+
+	labels, order := make([]int, n), make([]int, n)
+	for i := range labels {
+		labels[i], order[i] = i, i
+	}
+
+	weights := make([]float64, n)
+	touched, isTouched := []int{}, make([]bool, n)
+	candidates := []int{}
+	for range LabelPropagationMaxIterations {
+		random.Shuffle(n, func(i, j int) { order[i], order[j] = order[j], order[i] })
+		changed := false
+		for _, u := range order {
+			for _, a := range ig.out[u] {
+				if a.to == u {
+					continue
+				}
+				label := labels[a.to]
+				if !isTouched[label] {
+					isTouched[label] = true
+					touched = append(touched, label)
+				}
+				weights[label] += opts.weight(a.weight)
+			}
+			if len(touched) == 0 {
+				continue
+			}
+
+			top := 0.0
+			for _, label := range touched {
+				top = max(top, weights[label])
+			}
+			if !isTouched[labels[u]] || weights[labels[u]] < top {
+				candidates = candidates[:0]
+				for _, label := range touched {
+					if weights[label] == top {
+						candidates = append(candidates, label)
+					}
+				}
+				labels[u], changed = candidates[random.IntN(len(candidates))], true
+			}
+
+			for _, label := range touched {
+				weights[label], isTouched[label] = 0, false
+			}
+			touched = touched[:0]
+		}
+		if !changed {
+			break
+		}
+	}
+	return renumberPartition(ig.keys, labels)
+}
+
+/*
+ * Girvan-Newman: repeatedly removes the edge with the highest betweenness
+ * (ties by the smallest key), so edges between communities go first. Every
+ * time graph falls apart into more components, their modularity is checked;
+ * the best partition is returned. Betweenness is counted in hops, weights
+ * only matter for modularity. Recomputing betweenness after every removal
+ * makes it O(E^2 V), so graph is limited both by nodes and edges count.
+ */
+
+const (
+	GirvanNewmanNodesLimit = 300
+	GirvanNewmanEdgesLimit = 500
+)
+
+func GirvanNewman(gr *graph.Graph, options ...graph.Option[CommunityOptions]) (map[graph.TKey]int, error) {
+	if len(gr.Nodes) > GirvanNewmanNodesLimit {
+		return nil, graph.ThrowTooManyNodes(len(gr.Nodes), GirvanNewmanNodesLimit)
+	}
+	if len(gr.Edges) > GirvanNewmanEdgesLimit {
+		return nil, graph.ThrowTooManyEdges(len(gr.Edges), GirvanNewmanEdgesLimit)
+	}
+
+	ig := makeUndirectedIndexedGraph(gr)
+	removed := make(map[graph.TKey]bool, len(gr.Edges))
+	best, count := ig.components(removed)
+	bestQ := Modularity(gr, best, options...)
+
+	for range gr.Edges {
+		scores := ig.edgeBetweenness(removed)
+		var worst graph.TKey
+		found := false
+		for _, key := range sortedEdgeKeys(gr) {
+			if !removed[key] && (!found || scores[key] > scores[worst]) {
+				worst, found = key, true
+			}
+		}
+		removed[worst] = true
+
+		partition, parts := ig.components(removed)
+		if parts <= count {
+			continue
+		}
+		count = parts
+		if q := Modularity(gr, partition, options...); q > bestQ+1e-12 {
+			best, bestQ = partition, q
+		}
+	}
+	return best, nil
+}
+
+// Connected components without removed edges, and their number
+func (ig *indexedGraph) components(removed map[graph.TKey]bool) (map[graph.TKey]int, int) {
+	labels := make([]int, len(ig.keys))
+	for i := range labels {
+		labels[i] = -1
+	}
+	count := 0
+	for root := range ig.keys {
+		if labels[root] != -1 {
+			continue
+		}
+		labels[root] = count
+		queue := []int{root}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, a := range ig.out[u] {
+				if !removed[a.edge] && labels[a.to] == -1 {
+					labels[a.to] = count
+					queue = append(queue, a.to)
+				}
+			}
+		}
+		count++
+	}
+	return renumberPartition(ig.keys, labels), count
+}
+
+// Brandes for edges: share of shortest paths going through every edge
+func (ig *indexedGraph) edgeBetweenness(removed map[graph.TKey]bool) map[graph.TKey]float64 {
+	n := len(ig.keys)
+	scores := make(map[graph.TKey]float64)
+	dist, sigma, delta := make([]int, n), make([]float64, n), make([]float64, n)
+	pred := make([][]indexedArc, n) // arcs to predecessors
+	order := make([]int, 0, n)
+
+	for s := range n {
+		for i := range n {
+			dist[i], sigma[i], delta[i] = -1, 0, 0
+			pred[i] = pred[i][:0]
+		}
+		dist[s], sigma[s] = 0, 1
+		order = append(order[:0], s)
+		for head := 0; head < len(order); head++ {
+			u := order[head]
+			for _, a := range ig.out[u] {
+				if removed[a.edge] {
+					continue
+				}
+				if dist[a.to] == -1 {
+					dist[a.to] = dist[u] + 1
+					order = append(order, a.to)
+				}
+				if dist[a.to] == dist[u]+1 {
+					sigma[a.to] += sigma[u]
+					pred[a.to] = append(pred[a.to], indexedArc{to: u, edge: a.edge})
+				}
+			}
+		}
+
+		for i := len(order) - 1; i > 0; i-- {
+			w := order[i]
+			for _, a := range pred[w] {
+				share := sigma[a.to] / sigma[w] * (1 + delta[w])
+				scores[a.edge] += share
+				delta[a.to] += share
+			}
+		}
+	}
+	return scores
+}
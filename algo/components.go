@@ -0,0 +1,293 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Connectivity. All functions below return membership maps: node key to the
+ * number of its component. Components are numbered from 1, so the number can
+ * be printed as is. If you need the components themselves, use
+ * GroupComponents on the returned map.
+ */
+
+func GroupComponents(membership map[graph.TKey]int) [][]graph.TKey {
+	count := 0
+	for _, id := range membership {
+		count = max(count, id)
+	}
+
+	groups := make([][]graph.TKey, count)
+	for key, id := range membership {
+		groups[id-1] = append(groups[id-1], key)
+	}
+	for _, group := range groups {
+		slices.Sort(group)
+	}
+	return groups
+}
+
+/*
+ * Connected components of undirected graph. Components are numbered in order
+ * of their smallest node key
+ */
+
+func ConnectedComponents(gr *graph.Graph) (map[graph.TKey]int, error) {
+	if gr.Options.IsDirected {
+		return nil, graph.ThrowGraphDirected()
+	}
+	return labelComponents(gr, outArcs(gr)), nil
+}
+
+/*
+ * Weakly connected components -- components of graph with edge directions
+ * ignored. Works for undirected graphs too, where it is the same as
+ * ConnectedComponents
+ */
+
+func WeaklyConnectedComponents(gr *graph.Graph) map[graph.TKey]int {
+	return labelComponents(gr, undirectedArcs(gr))
+}
+
+func labelComponents(gr *graph.Graph, arcs map[graph.TKey][]arc) map[graph.TKey]int {
+	membership := make(map[graph.TKey]int, len(gr.Nodes))
+	count := 0
+
+	for _, root := range sortedNodeKeys(gr) {
+		if membership[root] != 0 {
+			continue
+		}
+
+		count++
+		membership[root] = count
+		queue := []graph.TKey{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			for _, a := range arcs[node] {
+				if membership[a.To] == 0 {
+					membership[a.To] = count
+					queue = append(queue, a.To)
+				}
+			}
+		}
+	}
+
+	return membership
+}
+
+/*
+ * Is graph connected? For directed graphs it checks weak connectivity, use
+ * IsStronglyConnected for the strong one. Empty graph is considered connected
+ */
+
+func IsConnected(gr *graph.Graph) bool {
+	return len(GroupComponents(WeaklyConnectedComponents(gr))) <= 1
+}
+
+func IsStronglyConnected(gr *graph.Graph) (bool, error) {
+	membership, err := StronglyConnectedTarjan(gr)
+	if err != nil {
+		return false, err
+	}
+	return len(GroupComponents(membership)) <= 1, nil
+}
+
+/*
+ * Strongly connected components via Tarjan's algorithm. DFS is iterative,
+ * otherwise big graphs could blow up the stack.
+ *
+ * Both Tarjan and Kosaraju number components in topological order of the
+ * condensation: every edge between different components goes from smaller
+ * number to bigger one.
+ */
+
+func StronglyConnectedTarjan(gr *graph.Graph) (map[graph.TKey]int, error) {
+	if !gr.Options.IsDirected {
+		return nil, graph.ThrowGraphNotDirected()
+	}
+
+	arcs := outArcs(gr)
+	index := make(map[graph.TKey]int, len(gr.Nodes))
+	low := make(map[graph.TKey]int, len(gr.Nodes))
+	onStack := make(map[graph.TKey]bool)
+	stack := []graph.TKey{}
+	found := make(map[graph.TKey]int, len(gr.Nodes))
+	counter, count := 0, 0
+
+	type frame struct {
+		node graph.TKey
+		next int
+	}
+
+	visit := func(node graph.TKey) {
+		index[node], low[node] = counter, counter
+		counter++
+		stack = append(stack, node)
+		onStack[node] = true
+	}
+
+	for _, root := range sortedNodeKeys(gr) {
+		if _, seen := index[root]; seen {
+			continue
+		}
+
+		visit(root)
+		callStack := []frame{{node: root}}
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			node := top.node
+
+			if top.next < len(arcs[node]) {
+				next := arcs[node][top.next].To
+				top.next++
+				if _, seen := index[next]; !seen {
+					visit(next)
+					callStack = append(callStack, frame{node: next})
+				} else if onStack[next] {
+					low[node] = min(low[node], index[next])
+				}
+				continue
+			}
+
+			if low[node] == index[node] {
+				count++
+				for {
+					last := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[last] = false
+					found[last] = count
+					if last == node {
+						break
+					}
+				}
+			}
+
+			callStack = callStack[:len(callStack)-1]
+			if len(callStack) > 0 {
+				parent := callStack[len(callStack)-1].node
+				low[parent] = min(low[parent], low[node])
+			}
+		}
+	}
+
+	// Tarjan finds sink components first, so reverse numbering
+	membership := make(map[graph.TKey]int, len(found))
+	for key, id := range found {
+		membership[key] = count - id + 1
+	}
+	return membership, nil
+}
+
+/*
+ * Strongly connected components via Kosaraju's algorithm: first DFS collects
+ * nodes in post-order, second one walks transposed graph in reversed order
+ */
+
+func StronglyConnectedKosaraju(gr *graph.Graph) (map[graph.TKey]int, error) {
+	if !gr.Options.IsDirected {
+		return nil, graph.ThrowGraphNotDirected()
+	}
+
+	arcs := outArcs(gr)
+	visited := make(map[graph.TKey]bool, len(gr.Nodes))
+	order := make([]graph.TKey, 0, len(gr.Nodes))
+
+	type frame struct {
+		node graph.TKey
+		next int
+	}
+
+	for _, root := range sortedNodeKeys(gr) {
+		if visited[root] {
+			continue
+		}
+
+		visited[root] = true
+		callStack := []frame{{node: root}}
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			if top.next < len(arcs[top.node]) {
+				next := arcs[top.node][top.next].To
+				top.next++
+				if !visited[next] {
+					visited[next] = true
+					callStack = append(callStack, frame{node: next})
+				}
+				continue
+			}
+			order = append(order, top.node)
+			callStack = callStack[:len(callStack)-1]
+		}
+	}
+
+	reversed := inArcs(gr)
+	membership := make(map[graph.TKey]int, len(gr.Nodes))
+	count := 0
+	for i := len(order) - 1; i >= 0; i-- {
+		root := order[i]
+		if membership[root] != 0 {
+			continue
+		}
+
+		count++
+		membership[root] = count
+		stack := []graph.TKey{root}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, a := range reversed[node] {
+				if membership[a.To] == 0 {
+					membership[a.To] = count
+					stack = append(stack, a.To)
+				}
+			}
+		}
+	}
+
+	return membership, nil
+}
+
+/*
+ * Condensation of directed graph: every strongly connected component is
+ * contracted into single node. Result is always a DAG. Node keys of the new
+ * graph are component numbers from the returned membership map, and there is
+ * at most one edge between any two components.
+ */
+
+func Condensation(gr *graph.Graph) (*graph.Graph, map[graph.TKey]int, error) {
+	membership, err := StronglyConnectedTarjan(gr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groups := GroupComponents(membership)
+	condensed := graph.MakeGraph(graph.WithGraphDirected(true))
+	for i, group := range groups {
+		key := graph.TKey(i + 1)
+		condensed.Nodes[key] = graph.MakeNode(key, graph.WithNodeLabel(fmt.Sprintf("SCC_%d (%d nodes)", key, len(group))))
+	}
+
+	seen := make(map[[2]int]bool)
+	edgeKey := graph.TKey(1)
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		from, to := membership[edge.Source], membership[edge.Destination]
+		if from == to || seen[[2]int{from, to}] {
+			continue
+		}
+		seen[[2]int{from, to}] = true
+		condensed.Edges[edgeKey] = graph.MakeEdge(edgeKey, graph.TKey(from), graph.TKey(to))
+		edgeKey++
+	}
+
+	condensed.RebuildAdjacencyMap()
+	return condensed, membership, nil
+}
@@ -0,0 +1,475 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Cycles. Every cycle is returned as edge keys in order of traversal, like
+ * in FindCycle, so parallel edges give different cycles. Self-loop is a
+ * cycle of one edge.
+ */
+
+/*
+ * Minimum mean-weight cycle in directed graph by Karp's algorithm. With
+ * D[k][v] the lightest walk of exactly k edges ending in v (from anywhere),
+ * the minimum mean is min over v of max over k of
+ * (D[n][v] - D[k][v]) / (n - k). D[n] is found by the first pass and every
+ * D[k] is compared with it during the second one, so only O(V) memory is
+ * needed; time is O(VE).
+ *
+ * Then weights are shifted by the mean, so the best cycle has zero weight
+ * and no cycle is negative; such a cycle consists of edges tight for
+ * Bellman-Ford potentials, and any cycle of tight edges will do.
+ *
+ * All of it is exact in int64: walks, cross-multiplied fractions and
+ * potentials stay below (V + 1)^2 times the biggest weight, so graph with
+ * bigger weights is rejected. Weights are unsigned, so there are no
+ * negative cycles to report, unlike in Bellman-Ford.
+ *
+ * Returns the cycle and its mean weight, or nil for acyclic graph.
+ */
+
+func MinimumMeanCycle(gr *graph.Graph) ([]graph.TKey, float64, error) {
+	if !gr.Options.IsDirected {
+		return nil, 0, graph.ThrowGraphNotDirected()
+	}
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	const inf = int64(-1)
+
+	limit := graph.TWeight(math.MaxInt64 / ((n + 1) * (n + 1)))
+	for _, edge := range gr.Edges {
+		if edge.Weight > limit {
+			return nil, 0, graph.ThrowInvalidParameter("weight", fmt.Sprintf("edge %d is heavier than %d allowed for %d nodes", edge.Key, limit, n))
+		}
+	}
+
+	// One step of walks: next[v] = min over u -> v of current[u] + w
+	step := func(current, next []int64) {
+		for v := range n {
+			next[v] = inf
+			for _, a := range ig.in[v] {
+				if current[a.to] != inf && (next[v] == inf || current[a.to]+int64(a.weight) < next[v]) {
+					next[v] = current[a.to] + int64(a.weight)
+				}
+			}
+		}
+	}
+
+	current, next := make([]int64, n), make([]int64, n)
+	for range n {
+		step(current, next)
+		current, next = next, current
+	}
+	last := slices.Clone(current)
+
+	// Best mean as fraction num / den
+	num, den := int64(0), int64(0)
+	worst := make([][2]int64, n)
+	clear(current)
+	for k := range n {
+		for v := range n {
+			if last[v] == inf || current[v] == inf {
+				continue
+			}
+			a, b := last[v]-current[v], int64(n-k)
+			if worst[v][1] == 0 || a*worst[v][1] > worst[v][0]*b {
+				worst[v] = [2]int64{a, b}
+			}
+		}
+		step(current, next)
+		current, next = next, current
+	}
+	for v := range n {
+		if worst[v][1] != 0 && (den == 0 || worst[v][0]*den < num*worst[v][1]) {
+			num, den = worst[v][0], worst[v][1]
+		}
+	}
+	if den == 0 {
+		return nil, 0, nil
+	}
+
+	// Potentials for weights w * den - num, starting from zero everywhere
+	potential := make([]int64, n)
+	for changed := true; changed; {
+		changed = false
+		for u := range n {
+			for _, a := range ig.out[u] {
+				if next := potential[u] + int64(a.weight)*den - num; next < potential[a.to] {
+					potential[a.to] = next
+					changed = true
+				}
+			}
+		}
+	}
+
+	tight := []graph.TKey{}
+	for u := range n {
+		for _, a := range ig.out[u] {
+			if potential[u]+int64(a.weight)*den-num == potential[a.to] {
+				tight = append(tight, a.edge)
+			}
+		}
+	}
+	sub, err := EdgeInducedSubgraph(gr, tight)
+	if err != nil {
+		return nil, 0, err
+	}
+	cycle, err := FindCycle(sub)
+	if err != nil {
+		return nil, 0, err
+	}
+	return cycle, float64(num) / float64(den), nil
+}
+
+/*
+ * All elementary cycles of directed graph by Johnson's algorithm, up to
+ * limit of them (limit <= 0 means all, and there may be exponentially
+ * many). Cycles are found by the smallest node s: search goes only through
+ * the strongly connected component of s among nodes not smaller than s, and
+ * node stays blocked while it has no way back to s, so time between two
+ * consecutive cycles is O(V + E).
+ *
+ * Every cycle starts with an edge out of its smallest node.
+ */
+
+func ElementaryCycles(gr *graph.Graph, limit int) ([][]graph.TKey, error) {
+	if !gr.Options.IsDirected {
+		return nil, graph.ThrowGraphNotDirected()
+	}
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+
+	cycles := [][]graph.TKey{}
+	full := func() bool { return limit > 0 && len(cycles) >= limit }
+
+	inComponent := make([]bool, n)
+	blocked := make([]bool, n)
+	blockers := make([]map[int]bool, n)
+	path := []graph.TKey{}
+
+	var unblock func(u int)
+	unblock = func(u int) {
+		blocked[u] = false
+		for w := range blockers[u] {
+			delete(blockers[u], w)
+			if blocked[w] {
+				unblock(w)
+			}
+		}
+	}
+
+	var circuit func(v, s int) bool
+	circuit = func(v, s int) bool {
+		found := false
+		blocked[v] = true
+		for _, a := range ig.out[v] {
+			if full() {
+				return found
+			}
+			if !inComponent[a.to] {
+				continue
+			}
+			if a.to == s {
+				cycles = append(cycles, append(slices.Clone(path), a.edge))
+				found = true
+			} else if !blocked[a.to] {
+				path = append(path, a.edge)
+				if circuit(a.to, s) {
+					found = true
+				}
+				path = path[:len(path)-1]
+			}
+		}
+
+		if found {
+			unblock(v)
+		} else {
+			for _, a := range ig.out[v] {
+				if inComponent[a.to] {
+					blockers[a.to][v] = true
+				}
+			}
+		}
+		return found
+	}
+
+	for s := 0; s < n && !full(); s++ {
+		forward := ig.reachableFrom(s, ig.out)
+		backward := ig.reachableFrom(s, ig.in)
+		for v := range n {
+			inComponent[v] = forward[v] && backward[v]
+			blocked[v] = false
+			blockers[v] = make(map[int]bool)
+		}
+		circuit(s, s)
+	}
+	return cycles, nil
+}
+
+// Nodes not smaller than s reachable from it along arcs
+func (ig *indexedGraph) reachableFrom(s int, arcs [][]indexedArc) []bool {
+	seen := make([]bool, len(ig.keys))
+	seen[s] = true
+	queue := []int{s}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, a := range arcs[u] {
+			if a.to >= s && !seen[a.to] {
+				seen[a.to] = true
+				queue = append(queue, a.to)
+			}
+		}
+	}
+	return seen
+}
+
+/*
+ * Cycle basis of undirected graph: every cycle (more precisely, every
+ * Eulerian subgraph) is a sum modulo 2 of basis cycles in a unique way.
+ * Basis has E - V + C cycles, where C is the number of components.
+ *
+ * Fundamental basis comes from BFS spanning forest: every non-tree edge
+ * closes exactly one cycle with tree paths. Cycle starts with that edge.
+ */
+
+func FundamentalCycleBasis(gr *graph.Graph) ([][]graph.TKey, error) {
+	if gr.Options.IsDirected {
+		return nil, graph.ThrowGraphDirected()
+	}
+	ig := makeUndirectedIndexedGraph(gr)
+	n := len(ig.keys)
+
+	parent := make([]indexedArc, n)
+	depth := make([]int, n)
+	for i := range depth {
+		depth[i] = -1
+	}
+	tree := make(map[graph.TKey]bool)
+	for root := range n {
+		if depth[root] != -1 {
+			continue
+		}
+		depth[root] = 0
+		queue := []int{root}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, a := range ig.out[u] {
+				if depth[a.to] == -1 {
+					depth[a.to] = depth[u] + 1
+					parent[a.to] = indexedArc{to: u, edge: a.edge}
+					tree[a.edge] = true
+					queue = append(queue, a.to)
+				}
+			}
+		}
+	}
+
+	basis := [][]graph.TKey{}
+	for _, key := range sortedEdgeKeys(gr) {
+		if tree[key] {
+			continue
+		}
+		edge := gr.Edges[key]
+		u, v := ig.index[edge.Source], ig.index[edge.Destination]
+
+		// Edge u - v, then up from v and down to u
+		cycle, down := []graph.TKey{key}, []graph.TKey{}
+		for u != v {
+			if depth[v] >= depth[u] {
+				cycle = append(cycle, parent[v].edge)
+				v = parent[v].to
+			} else {
+				down = append(down, parent[u].edge)
+				u = parent[u].to
+			}
+		}
+		slices.Reverse(down)
+		basis = append(basis, append(cycle, down...))
+	}
+	return basis, nil
+}
+
+/*
+ * Minimum cycle basis by Horton's algorithm: the lightest basis consists of
+ * cycles made of an edge x - y and shortest paths from some node v to x and
+ * to y. All such candidates are sorted by weight, and greedy Gaussian
+ * elimination over GF(2) keeps every one independent of the lighter ones.
+ * Weight is the sum of Edge.Weight if weighted, number of edges otherwise.
+ *
+ * There are O(VE) candidates of O(V) edges, so graph size is limited.
+ */
+
+const CycleBasisNodesLimit = 200
+
+func MinimumCycleBasis(gr *graph.Graph, weighted bool) ([][]graph.TKey, error) {
+	if gr.Options.IsDirected {
+		return nil, graph.ThrowGraphDirected()
+	}
+	if len(gr.Nodes) > CycleBasisNodesLimit {
+		return nil, graph.ThrowTooManyNodes(len(gr.Nodes), CycleBasisNodesLimit)
+	}
+	ig := makeUndirectedIndexedGraph(gr)
+	n := len(ig.keys)
+	edgeKeys := sortedEdgeKeys(gr)
+	edgeIndex := make(map[graph.TKey]int, len(edgeKeys))
+	for i, key := range edgeKeys {
+		edgeIndex[key] = i
+	}
+	length := func(key graph.TKey) int64 {
+		if weighted {
+			return int64(gr.Edges[key].Weight)
+		}
+		return 1
+	}
+
+	type candidate struct {
+		weight int64
+		edges  []graph.TKey
+	}
+	candidates := []candidate{}
+	seen := make(map[string]bool)
+	add := func(weight int64, edges []graph.TKey) {
+		set := makeBitset(len(edgeKeys))
+		for _, key := range edges {
+			set.set(edgeIndex[key])
+		}
+		id := set.key()
+		if !seen[id] {
+			seen[id] = true
+			candidates = append(candidates, candidate{weight, edges})
+		}
+	}
+
+	dist := make([]int64, n)
+	parent := make([]indexedArc, n)
+	branch := make([]int, n)
+	for v := range n {
+		// Shortest path tree from v, branch is the child of v above node
+		for i := range dist {
+			dist[i], branch[i] = -1, -1
+		}
+		dist[v] = 0
+		queue := &distHeap{{node: v, dist: 0}}
+		done := make([]bool, n)
+		for queue.Len() > 0 {
+			item := heap.Pop(queue).(distItem)
+			u := item.node
+			if done[u] {
+				continue
+			}
+			done[u] = true
+			if u != v {
+				branch[u] = branch[parent[u].to]
+				if parent[u].to == v {
+					branch[u] = u
+				}
+			}
+			for _, a := range ig.out[u] {
+				if next := item.dist + length(a.edge); !done[a.to] && (dist[a.to] == -1 || next < dist[a.to]) {
+					dist[a.to] = next
+					parent[a.to] = indexedArc{to: u, edge: a.edge}
+					heap.Push(queue, distItem{node: a.to, dist: next})
+				}
+			}
+		}
+
+		for _, key := range edgeKeys {
+			edge := gr.Edges[key]
+			x, y := ig.index[edge.Source], ig.index[edge.Destination]
+			if x == y {
+				if x == v {
+					add(length(key), []graph.TKey{key})
+				}
+				continue
+			}
+			if dist[x] == -1 || (parent[x].edge == key && x != v) || (parent[y].edge == key && y != v) {
+				continue
+			}
+			if x != v && y != v && branch[x] == branch[y] {
+				continue
+			}
+
+			// Down from v to x, edge x - y, up from y to v
+			cycle := []graph.TKey{}
+			for u := x; u != v; u = parent[u].to {
+				cycle = append(cycle, parent[u].edge)
+			}
+			slices.Reverse(cycle)
+			cycle = append(cycle, key)
+			for u := y; u != v; u = parent[u].to {
+				cycle = append(cycle, parent[u].edge)
+			}
+			add(dist[x]+length(key)+dist[y], cycle)
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return cmp.Or(cmp.Compare(a.weight, b.weight), cmp.Compare(len(a.edges), len(b.edges)))
+	})
+
+	dimension := len(edgeKeys) - n + len(GroupComponents(WeaklyConnectedComponents(gr)))
+	basis := [][]graph.TKey{}
+	rows, pivots := []bitset{}, []int{}
+	for _, c := range candidates {
+		if len(basis) == dimension {
+			break
+		}
+		row := makeBitset(len(edgeKeys))
+		for _, key := range c.edges {
+			row.set(edgeIndex[key])
+		}
+		for i, other := range rows {
+			if row.has(pivots[i]) {
+				row.xor(other)
+			}
+		}
+		if pivot := row.lowest(); pivot != -1 {
+			rows, pivots = append(rows, row), append(pivots, pivot)
+			basis = append(basis, c.edges)
+		}
+	}
+	return basis, nil
+}
+
+func (bs bitset) xor(other bitset) {
+	for i := range bs {
+		bs[i] ^= other[i]
+	}
+}
+
+// Index of the lowest set bit, -1 for empty set
+func (bs bitset) lowest() int {
+	for i, word := range bs {
+		if word != 0 {
+			return i*64 + bits.TrailingZeros64(word)
+		}
+	}
+	return -1
+}
+
+// Bytes of bitset as map key
+func (bs bitset) key() string {
+	data := make([]byte, 0, 8*len(bs))
+	for _, word := range bs {
+		for range 8 {
+			data = append(data, byte(word))
+			word >>= 8
+		}
+	}
+	return string(data)
+}
@@ -0,0 +1,299 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"cmp"
+	"container/heap"
+	"math/big"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Topological sort via Kahn's algorithm. Among all nodes ready to be taken
+ * the one with the smallest key goes first, so the result is the
+ * lexicographically smallest topological order. If graph has a cycle, the
+ * error is *graph.GraphNotDAGError with the cycle inside.
+ */
+
+func TopologicalSortKahn(gr *graph.Graph) ([]graph.TKey, error) {
+	if !gr.Options.IsDirected {
+		return nil, graph.ThrowGraphNotDirected()
+	}
+
+	arcs := outArcs(gr)
+	inDegree := make(map[graph.TKey]int, len(gr.Nodes))
+	for _, edge := range gr.Edges {
+		inDegree[edge.Destination]++
+	}
+
+	ready := &keyHeap{}
+	for _, key := range sortedNodeKeys(gr) {
+		if inDegree[key] == 0 {
+			heap.Push(ready, key)
+		}
+	}
+
+	order := make([]graph.TKey, 0, len(gr.Nodes))
+	for ready.Len() > 0 {
+		node := heap.Pop(ready).(graph.TKey)
+		order = append(order, node)
+		for _, a := range arcs[node] {
+			inDegree[a.To]--
+			if inDegree[a.To] == 0 {
+				heap.Push(ready, a.To)
+			}
+		}
+	}
+
+	if len(order) != len(gr.Nodes) {
+		cycle, _ := FindCycle(gr)
+		return nil, graph.ThrowGraphNotDAG(cycle)
+	}
+	return order, nil
+}
+
+/*
+ * Topological sort via DFS: reversed post-order. Roots and neighbours are
+ * visited in descending key order, so after reversal smaller keys tend to go
+ * first. Order is deterministic, but not necessarily the same as Kahn's one.
+ */
+
+func TopologicalSortDFS(gr *graph.Graph) ([]graph.TKey, error) {
+	if !gr.Options.IsDirected {
+		return nil, graph.ThrowGraphNotDirected()
+	}
+
+	arcs := outArcs(gr)
+	for key := range arcs {
+		slices.SortStableFunc(arcs[key], func(a, b arc) int {
+			return cmp.Compare(b.To, a.To)
+		})
+	}
+
+	const (
+		white = iota
+		grey
+		black
+	)
+	color := make(map[graph.TKey]int, len(gr.Nodes))
+	order := make([]graph.TKey, 0, len(gr.Nodes))
+
+	type frame struct {
+		node graph.TKey
+		next int
+	}
+
+	roots := sortedNodeKeys(gr)
+	slices.Reverse(roots)
+	for _, root := range roots {
+		if color[root] != white {
+			continue
+		}
+
+		color[root] = grey
+		callStack := []frame{{node: root}}
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			if top.next < len(arcs[top.node]) {
+				next := arcs[top.node][top.next].To
+				top.next++
+				switch color[next] {
+				case white:
+					color[next] = grey
+					callStack = append(callStack, frame{node: next})
+				case grey:
+					cycle, _ := FindCycle(gr)
+					return nil, graph.ThrowGraphNotDAG(cycle)
+				}
+				continue
+			}
+			color[top.node] = black
+			order = append(order, top.node)
+			callStack = callStack[:len(callStack)-1]
+		}
+	}
+
+	slices.Reverse(order)
+	return order, nil
+}
+
+func TopologicalSort(gr *graph.Graph) ([]graph.TKey, error) {
+	return TopologicalSortKahn(gr)
+}
+
+/*
+ * Find any cycle in directed graph. Cycle is returned as edge keys in order
+ * of traversal, so it is unambiguous even with parallel edges. If there is no
+ * cycle, nil is returned.
+ */
+
+func FindCycle(gr *graph.Graph) ([]graph.TKey, error) {
+	if !gr.Options.IsDirected {
+		return nil, graph.ThrowGraphNotDirected()
+	}
+
+	arcs := outArcs(gr)
+	const (
+		white = iota
+		grey
+		black
+	)
+	color := make(map[graph.TKey]int, len(gr.Nodes))
+
+	type frame struct {
+		node  graph.TKey
+		entry graph.TKey // edge we came by
+		next  int
+	}
+
+	for _, root := range sortedNodeKeys(gr) {
+		if color[root] != white {
+			continue
+		}
+
+		color[root] = grey
+		callStack := []frame{{node: root}}
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			if top.next >= len(arcs[top.node]) {
+				color[top.node] = black
+				callStack = callStack[:len(callStack)-1]
+				continue
+			}
+
+			a := arcs[top.node][top.next]
+			top.next++
+			switch color[a.To] {
+			case white:
+				color[a.To] = grey
+				callStack = append(callStack, frame{node: a.To, entry: a.Edge})
+			case grey:
+				// Grey node is on the stack, so unwind the stack down to it
+				cycle := []graph.TKey{a.Edge}
+				for i := len(callStack) - 1; callStack[i].node != a.To; i-- {
+					cycle = append(cycle, callStack[i].entry)
+				}
+				slices.Reverse(cycle)
+				return cycle, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func IsDAG(gr *graph.Graph) (bool, error) {
+	cycle, err := FindCycle(gr)
+	if err != nil {
+		return false, err
+	}
+	return cycle == nil, nil
+}
+
+/*
+ * Longest (heaviest by Edge.Weight) path in DAG. Returns the path as node
+ * keys and its total weight. For graph without edges the path is a single
+ * node with zero weight.
+ */
+
+func LongestPathInDAG(gr *graph.Graph) ([]graph.TKey, graph.TWeight, error) {
+	order, err := TopologicalSort(gr)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(order) == 0 {
+		return nil, 0, nil
+	}
+
+	arcs := outArcs(gr)
+	dist := make(map[graph.TKey]graph.TWeight, len(order))
+	prev := make(map[graph.TKey]graph.TKey, len(order))
+	for _, node := range order {
+		for _, a := range arcs[node] {
+			weight := dist[node] + gr.Edges[a.Edge].Weight
+			if _, seen := prev[a.To]; !seen || weight > dist[a.To] {
+				dist[a.To] = weight
+				prev[a.To] = node
+			}
+		}
+	}
+
+	end := order[0]
+	for _, node := range order {
+		if dist[node] > dist[end] {
+			end = node
+		}
+	}
+
+	path := []graph.TKey{end}
+	for {
+		node, ok := prev[path[len(path)-1]]
+		if !ok {
+			break
+		}
+		path = append(path, node)
+	}
+	slices.Reverse(path)
+	return path, dist[end], nil
+}
+
+/*
+ * Count all paths from src to dst in DAG. Parallel edges give different
+ * paths. The number grows exponentially with graph size, so big.Int is used.
+ */
+
+func CountPathsInDAG(gr *graph.Graph, src, dst graph.TKey) (*big.Int, error) {
+	if _, err := gr.GetNodeByKey(src); err != nil {
+		return nil, err
+	}
+	if _, err := gr.GetNodeByKey(dst); err != nil {
+		return nil, err
+	}
+
+	order, err := TopologicalSort(gr)
+	if err != nil {
+		return nil, err
+	}
+
+	arcs := outArcs(gr)
+	count := make(map[graph.TKey]*big.Int, len(order))
+	count[src] = big.NewInt(1)
+	for _, node := range order {
+		if count[node] == nil {
+			continue
+		}
+		for _, a := range arcs[node] {
+			if count[a.To] == nil {
+				count[a.To] = new(big.Int)
+			}
+			count[a.To].Add(count[a.To], count[node])
+		}
+	}
+
+	if count[dst] == nil {
+		return new(big.Int), nil
+	}
+	return count[dst], nil
+}
+
+/*
+ * Min-heap of keys for container/heap
+ */
+
+type keyHeap []graph.TKey
+
+func (h keyHeap) Len() int           { return len(h) }
+func (h keyHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h keyHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *keyHeap) Push(x any)        { *h = append(*h, x.(graph.TKey)) }
+func (h *keyHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
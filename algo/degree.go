@@ -0,0 +1,247 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Degree statistics. For undirected graphs in-, out- and total degree are all
+ * the same, and self-loop adds 2 to the degree. For directed graphs total
+ * degree is in-degree plus out-degree. Parallel edges are counted each.
+ */
+
+type DegreeKind int
+
+const (
+	DegreeTotal DegreeKind = iota
+	DegreeIn
+	DegreeOut
+)
+
+func Degrees(gr *graph.Graph, kind DegreeKind) map[graph.TKey]int {
+	degrees := make(map[graph.TKey]int, len(gr.Nodes))
+	for key := range gr.Nodes {
+		degrees[key] = 0
+	}
+	for _, edge := range gr.Edges {
+		if !gr.Options.IsDirected || kind != DegreeIn {
+			degrees[edge.Source]++
+		}
+		if !gr.Options.IsDirected || kind != DegreeOut {
+			degrees[edge.Destination]++
+		}
+	}
+	return degrees
+}
+
+/*
+ * Summary of degrees: extremes, mean, median and distribution, which maps
+ * degree to the number of nodes having it. Empty graph gives zero stats.
+ */
+
+type DegreeStats struct {
+	Min          int
+	Max          int
+	Mean         float64
+	Median       float64
+	Distribution map[int]int
+}
+
+func DegreeStatistics(gr *graph.Graph, kind DegreeKind) DegreeStats {
+	degrees := Degrees(gr, kind)
+	stats := DegreeStats{Distribution: make(map[int]int)}
+	if len(degrees) == 0 {
+		return stats
+	}
+
+	sequence := make([]int, 0, len(degrees))
+	sum := 0
+	for _, degree := range degrees {
+		sequence = append(sequence, degree)
+		stats.Distribution[degree]++
+		sum += degree
+	}
+	slices.Sort(sequence)
+
+	n := len(sequence)
+	stats.Min, stats.Max = sequence[0], sequence[n-1]
+	stats.Mean = float64(sum) / float64(n)
+	if n%2 == 1 {
+		stats.Median = float64(sequence[n/2])
+	} else {
+		stats.Median = float64(sequence[n/2-1]+sequence[n/2]) / 2
+	}
+	return stats
+}
+
+/*
+ * Histogram of degrees split into given number of equal-width bins covering
+ * [Min, Max]. Bin holds degrees From..To inclusive. If there are more bins
+ * than distinct degree values, every bin is a single degree.
+ */
+
+type HistogramBin struct {
+	From  int
+	To    int
+	Count int
+}
+
+func DegreeHistogram(gr *graph.Graph, kind DegreeKind, bins int) []HistogramBin {
+	stats := DegreeStatistics(gr, kind)
+	if len(gr.Nodes) == 0 || bins <= 0 {
+		return []HistogramBin{}
+	}
+
+	span := stats.Max - stats.Min + 1
+	bins = min(bins, span)
+	width := (span + bins - 1) / bins
+	histogram := []HistogramBin{}
+	for from := stats.Min; from <= stats.Max; from += width {
+		bin := HistogramBin{From: from, To: min(from+width-1, stats.Max)}
+		for degree := bin.From; degree <= bin.To; degree++ {
+			bin.Count += stats.Distribution[degree]
+		}
+		histogram = append(histogram, bin)
+	}
+	return histogram
+}
+
+/*
+ * Degree assortativity by Newman: Pearson correlation of degrees at the two
+ * ends of edges. Positive if hubs link to hubs, negative if hubs link to
+ * leaves. Undirected edges are taken in both directions; for directed ones
+ * out-degree of source is compared with in-degree of destination.
+ *
+ * Returns false if it is undefined: no edges, or all ends have the same
+ * degree (e.g. in regular graphs).
+ */
+
+func DegreeAssortativity(gr *graph.Graph) (float64, bool) {
+	out, in := Degrees(gr, DegreeOut), Degrees(gr, DegreeIn)
+
+	var xs, ys []float64
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		xs = append(xs, float64(out[edge.Source]))
+		ys = append(ys, float64(in[edge.Destination]))
+		if !gr.Options.IsDirected {
+			xs = append(xs, float64(out[edge.Destination]))
+			ys = append(ys, float64(in[edge.Source]))
+		}
+	}
+	if len(xs) == 0 {
+		return 0, false
+	}
+
+	var meanX, meanY float64
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= float64(len(xs))
+	meanY /= float64(len(ys))
+
+	var cov, varX, varY float64
+	for i := range xs {
+		dx, dy := xs[i]-meanX, ys[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return 0, false
+	}
+	return cov / math.Sqrt(varX*varY), true
+}
+
+/*
+ * Erdős–Gallai theorem: non-increasing sequence d_1..d_n is a degree
+ * sequence of some simple graph iff its sum is even and for every k
+ *
+ *   d_1 + ... + d_k <= k (k - 1) + min(d_{k+1}, k) + ... + min(d_n, k)
+ */
+
+func IsGraphical(sequence []int) bool {
+	degrees := slices.Clone(sequence)
+	slices.SortFunc(degrees, func(a, b int) int { return b - a })
+
+	sum := 0
+	for _, d := range degrees {
+		if d < 0 || d >= len(degrees) {
+			return false
+		}
+		sum += d
+	}
+	if sum%2 != 0 {
+		return false
+	}
+
+	left := 0
+	for k := 1; k <= len(degrees); k++ {
+		left += degrees[k-1]
+		right := k * (k - 1)
+		for _, d := range degrees[k:] {
+			right += min(d, k)
+		}
+		if left > right {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+ * Havel–Hakimi construction of a simple undirected graph with given degree
+ * sequence: node with the biggest remaining degree d is connected to the next
+ * d nodes with the biggest remaining degrees, until all degrees are used.
+ * Node i+1 gets degree sequence[i], edge keys go from 1.
+ */
+
+func HavelHakimi(sequence []int) (*graph.Graph, error) {
+	if !IsGraphical(sequence) {
+		return nil, graph.ThrowSequenceNotGraphical()
+	}
+
+	gr := graph.MakeGraph()
+	remaining := make(map[graph.TKey]int, len(sequence))
+	keys := make([]graph.TKey, len(sequence))
+	for i, d := range sequence {
+		keys[i] = graph.TKey(i + 1)
+		gr.Nodes[keys[i]] = graph.MakeNode(keys[i])
+		remaining[keys[i]] = d
+	}
+
+	byRemaining := func(a, b graph.TKey) int {
+		if remaining[a] != remaining[b] {
+			return remaining[b] - remaining[a]
+		}
+		return cmp.Compare(a, b)
+	}
+
+	edgeKey := graph.TKey(1)
+	for len(keys) > 0 {
+		slices.SortFunc(keys, byRemaining)
+		node := keys[0]
+		d := remaining[node]
+		if d == 0 {
+			break
+		}
+		remaining[node] = 0
+		for _, other := range keys[1 : d+1] {
+			gr.Edges[edgeKey] = graph.MakeEdge(edgeKey, node, other)
+			remaining[other]--
+			edgeKey++
+		}
+	}
+
+	gr.RebuildAdjacencyMap()
+	return gr, nil
+}
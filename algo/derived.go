@@ -0,0 +1,389 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Derived graphs. Each of them is a new graph, original one is never changed.
+ * Where result keeps nodes and edges of original graph, it is built from
+ * Copy, so keys, labels, weights and attributes stay as they were. Edges
+ * added by an operation get keys after the biggest one used, without weight
+ * and label, like in Complement.
+ */
+
+// Fixed-size set of dense indices
+type bitset []uint64
+
+func makeBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (bs bitset) set(i int) {
+	bs[i/64] |= 1 << (i % 64)
+}
+
+func (bs bitset) has(i int) bool {
+	return bs[i/64]&(1<<(i%64)) != 0
+}
+
+func (bs bitset) union(other bitset) {
+	for i := range bs {
+		bs[i] |= other[i]
+	}
+}
+
+func (bs bitset) count() int {
+	total := 0
+	for _, word := range bs {
+		total += bits.OnesCount64(word)
+	}
+	return total
+}
+
+// Ends of all edges, to skip adding edges that already exist
+func adjacentEnds(gr *graph.Graph) map[[2]graph.TKey]bool {
+	adjacent := make(map[[2]graph.TKey]bool, len(gr.Edges))
+	for _, edge := range gr.Edges {
+		adjacent[edgeEnds(gr, edge)] = true
+	}
+	return adjacent
+}
+
+/*
+ * Transpose (reverse) of directed graph: every edge turned the other way.
+ * Keys, weights and labels are kept.
+ */
+
+func Transpose(gr *graph.Graph) (*graph.Graph, error) {
+	if !gr.Options.IsDirected {
+		return nil, graph.ThrowGraphNotDirected()
+	}
+
+	result := gr.Copy()
+	for _, edge := range result.Edges {
+		edge.Source, edge.Destination = edge.Destination, edge.Source
+	}
+	result.RebuildAdjacencyMap()
+	return result, nil
+}
+
+/*
+ * Line graph: node for every edge of gr, with the same key. Its label is the
+ * label of edge, or "u-v" by ends if edge has none. In undirected graph two
+ * nodes are adjacent if edges share an end; in directed one there is an arc
+ * e -> f if f starts where e ends. Result is simple: parallel edges of gr
+ * give one edge in line graph, and there are no self-loops. Edge keys go
+ * from 1.
+ */
+
+func LineGraph(gr *graph.Graph) *graph.Graph {
+	result := graph.MakeGraph(graph.WithGraphDirected(gr.Options.IsDirected))
+	edges := sortedEdgeKeys(gr)
+	for _, key := range edges {
+		edge := gr.Edges[key]
+		label := edge.Label
+		if label == "" {
+			label = fmt.Sprintf("%s-%s", nodeName(gr.Nodes[edge.Source]), nodeName(gr.Nodes[edge.Destination]))
+		}
+		result.Nodes[key] = graph.MakeNode(key, graph.WithNodeLabel(label))
+	}
+
+	// Edges incident to every node: outgoing for directed graph
+	incident := make(map[graph.TKey][]graph.TKey, len(gr.Nodes))
+	for _, key := range edges {
+		edge := gr.Edges[key]
+		incident[edge.Source] = append(incident[edge.Source], key)
+		if !gr.Options.IsDirected && edge.Destination != edge.Source {
+			incident[edge.Destination] = append(incident[edge.Destination], key)
+		}
+	}
+
+	edgeKey := graph.TKey(1)
+	seen := make(map[[2]graph.TKey]bool)
+	addEdge := func(e, f graph.TKey) {
+		if e == f {
+			return
+		}
+		if !gr.Options.IsDirected && e > f {
+			e, f = f, e
+		}
+		if seen[[2]graph.TKey{e, f}] {
+			return
+		}
+		seen[[2]graph.TKey{e, f}] = true
+		result.Edges[edgeKey] = graph.MakeEdge(edgeKey, e, f)
+		edgeKey++
+	}
+
+	if gr.Options.IsDirected {
+		for _, e := range edges {
+			for _, f := range incident[gr.Edges[e].Destination] {
+				addEdge(e, f)
+			}
+		}
+	} else {
+		for _, node := range sortedNodeKeys(gr) {
+			for i, e := range incident[node] {
+				for _, f := range incident[node][i+1:] {
+					addEdge(e, f)
+				}
+			}
+		}
+	}
+
+	result.RebuildAdjacencyMap()
+	return result
+}
+
+/*
+ * k-th power of graph: u and v are adjacent if v is reachable from u in at
+ * most k edges. Original edges are kept, new ones are added for pairs at
+ * distance from 2 to k, with weight equal to that distance. k <= 1 gives a
+ * plain copy. Power may be almost complete graph, so its edges are counted
+ * before building and limited by ResultSizeLimit.
+ */
+
+func Power(gr *graph.Graph, k int) (*graph.Graph, error) {
+	if k <= 1 {
+		return gr.Copy(), nil
+	}
+
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	dist := make([]int, n)
+	for i := range dist {
+		dist[i] = -1
+	}
+
+	// Calls visit for every new edge, BFS from every node stops at depth k
+	walk := func(visit func(root, v, d int)) {
+		for root := range n {
+			dist[root] = 0
+			visited := []int{root}
+			for head := 0; head < len(visited); head++ {
+				u := visited[head]
+				if dist[u] == k {
+					continue
+				}
+				for _, a := range ig.out[u] {
+					if dist[a.to] == -1 {
+						dist[a.to] = dist[u] + 1
+						visited = append(visited, a.to)
+					}
+				}
+			}
+
+			for _, v := range visited {
+				if dist[v] >= 2 && (gr.Options.IsDirected || root < v) {
+					visit(root, v, dist[v])
+				}
+				dist[v] = -1
+			}
+		}
+	}
+
+	added := 0
+	walk(func(int, int, int) { added++ })
+	if len(gr.Edges)+added > ResultSizeLimit {
+		return nil, graph.ThrowResultTooBig("edges", len(gr.Edges)+added, ResultSizeLimit)
+	}
+
+	result := gr.Copy()
+	keys := makeKeyAllocator(gr.Edges)
+	walk(func(root, v, d int) {
+		key := keys.take(0)
+		result.Edges[key] = graph.MakeEdge(key, ig.keys[root], ig.keys[v], graph.WithEdgeWeight(graph.TWeight(d)))
+	})
+
+	result.RebuildAdjacencyMap()
+	return result, nil
+}
+
+func Square(gr *graph.Graph) (*graph.Graph, error) {
+	return Power(gr, 2)
+}
+
+/*
+ * Transitive closure: edge u -> v for every v reachable from u. Reachability
+ * is computed by Warshall's algorithm on bit rows, so it is O(V^3 / 64) time
+ * and O(V^2 / 8) memory, so graph is limited by TransitiveClosureNodesLimit.
+ * Closure edges are counted before building and limited by ResultSizeLimit.
+ * For undirected graph this makes every connected component complete. No
+ * self-loops are added.
+ */
+
+const TransitiveClosureNodesLimit = 3000
+
+func TransitiveClosure(gr *graph.Graph) (*graph.Graph, error) {
+	if len(gr.Nodes) > TransitiveClosureNodesLimit {
+		return nil, graph.ThrowTooManyNodes(len(gr.Nodes), TransitiveClosureNodesLimit)
+	}
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	reach := make([]bitset, n)
+	for u := range n {
+		reach[u] = makeBitset(n)
+		for _, a := range ig.out[u] {
+			reach[u].set(a.to)
+		}
+	}
+
+	for w := range n {
+		for u := range n {
+			if reach[u].has(w) {
+				reach[u].union(reach[w])
+			}
+		}
+	}
+
+	// Every reachable pair gives an edge, undirected ones are counted twice
+	pairs := 0
+	for u := range n {
+		pairs += reach[u].count()
+		if reach[u].has(u) {
+			pairs--
+		}
+	}
+	if !gr.Options.IsDirected {
+		pairs /= 2
+	}
+	if pairs > ResultSizeLimit {
+		return nil, graph.ThrowResultTooBig("edges", pairs, ResultSizeLimit)
+	}
+
+	result := gr.Copy()
+	adjacent := adjacentEnds(gr)
+	keys := makeKeyAllocator(gr.Edges)
+	for u := range n {
+		for v := range n {
+			if u == v || !reach[u].has(v) || !gr.Options.IsDirected && v < u {
+				continue
+			}
+			ends := [2]graph.TKey{ig.keys[u], ig.keys[v]}
+			if adjacent[ends] {
+				continue
+			}
+			key := keys.take(0)
+			result.Edges[key] = graph.MakeEdge(key, ends[0], ends[1])
+		}
+	}
+
+	result.RebuildAdjacencyMap()
+	return result, nil
+}
+
+/*
+ * Transitive reduction of DAG: the smallest subgraph with the same
+ * reachability. Edge u -> v is dropped if v can be reached from u some other
+ * way; of parallel edges only the one with the smallest key stays.
+ *
+ * Descendants of every node are collected as bit rows in reverse
+ * topological order, and edge u -> v is redundant if v is a descendant of
+ * some other child of u. O(VE / 64).
+ */
+
+func TransitiveReduction(gr *graph.Graph) (*graph.Graph, error) {
+	order, err := TopologicalSort(gr)
+	if err != nil {
+		return nil, err
+	}
+
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	descendants := make([]bitset, n)
+	for i := len(order) - 1; i >= 0; i-- {
+		u := ig.index[order[i]]
+		descendants[u] = makeBitset(n)
+		for _, a := range ig.out[u] {
+			descendants[u].set(a.to)
+			descendants[u].union(descendants[a.to])
+		}
+	}
+
+	result := gr.Copy()
+	for u := range n {
+		redundant := makeBitset(n)
+		for _, a := range ig.out[u] {
+			redundant.union(descendants[a.to])
+		}
+		kept := make(map[int]graph.TKey)
+		for _, a := range ig.out[u] {
+			if redundant.has(a.to) {
+				delete(result.Edges, a.edge)
+			} else if other, ok := kept[a.to]; ok {
+				delete(result.Edges, max(a.edge, other))
+				kept[a.to] = min(a.edge, other)
+			} else {
+				kept[a.to] = a.edge
+			}
+		}
+	}
+
+	result.RebuildAdjacencyMap()
+	return result, nil
+}
+
+/*
+ * Induced subgraph: given nodes and all edges between them. Edge-induced
+ * subgraph: given edges and their ends. Error is returned if some key does
+ * not exist.
+ */
+
+func InducedSubgraph(gr *graph.Graph, nodes []graph.TKey) (*graph.Graph, error) {
+	keep := make(map[graph.TKey]bool, len(nodes))
+	for _, key := range nodes {
+		if _, err := gr.GetNodeByKey(key); err != nil {
+			return nil, err
+		}
+		keep[key] = true
+	}
+
+	result := gr.Copy()
+	for key := range gr.Nodes {
+		if !keep[key] {
+			delete(result.Nodes, key)
+		}
+	}
+	for key, edge := range gr.Edges {
+		if !keep[edge.Source] || !keep[edge.Destination] {
+			delete(result.Edges, key)
+		}
+	}
+	result.RebuildAdjacencyMap()
+	return result, nil
+}
+
+func EdgeInducedSubgraph(gr *graph.Graph, edges []graph.TKey) (*graph.Graph, error) {
+	keep := make(map[graph.TKey]bool, len(edges))
+	ends := make(map[graph.TKey]bool, 2*len(edges))
+	for _, key := range edges {
+		edge, err := gr.GetEdgeByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		keep[key] = true
+		ends[edge.Source], ends[edge.Destination] = true, true
+	}
+
+	result := gr.Copy()
+	for key := range gr.Nodes {
+		if !ends[key] {
+			delete(result.Nodes, key)
+		}
+	}
+	for key := range gr.Edges {
+		if !keep[key] {
+			delete(result.Edges, key)
+		}
+	}
+	result.RebuildAdjacencyMap()
+	return result, nil
+}
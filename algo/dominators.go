@@ -0,0 +1,335 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Dominators in control-flow graphs. Graph must be directed, entry is the
+ * node where control starts. Node d dominates v if every path from entry to
+ * v goes through d; immediate dominator of v is its closest strict
+ * dominator. Nodes unreachable from entry have no dominators and are left
+ * out of all results.
+ */
+
+/*
+ * Lengauer–Tarjan: nodes are numbered in DFS order, then semi-dominators
+ * are computed in reverse order with a path-compressed forest, and
+ * immediate dominators are derived from them. O(E log V). Returns idom by
+ * dense index, -1 for unreachable nodes, root is its own idom.
+ */
+
+func lengauerTarjan(succ, pred [][]indexedArc, root int) []int {
+	n := len(succ)
+	number := make([]int, n) // DFS number from 1, 0 for not visited
+	vertex := make([]int, 1, n+1)
+	parent, semi, idom := make([]int, n), make([]int, n), make([]int, n)
+	ancestor, label := make([]int, n), make([]int, n)
+	for v := range n {
+		idom[v], ancestor[v], label[v] = -1, -1, v
+	}
+
+	// Iterative DFS: node is numbered when popped for the first time
+	type frame struct{ node, parent int }
+	stack := []frame{{root, -1}}
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if number[top.node] != 0 {
+			continue
+		}
+		vertex = append(vertex, top.node)
+		number[top.node] = len(vertex) - 1
+		semi[top.node] = number[top.node]
+		parent[top.node] = top.parent
+		for i := len(succ[top.node]) - 1; i >= 0; i-- {
+			if next := succ[top.node][i].to; number[next] == 0 {
+				stack = append(stack, frame{next, top.node})
+			}
+		}
+	}
+
+	compress := func(v int) {
+		var path []int
+		for ; ancestor[ancestor[v]] != -1; v = ancestor[v] {
+			path = append(path, v)
+		}
+		for i := len(path) - 1; i >= 0; i-- {
+			u := path[i]
+			if semi[label[ancestor[u]]] < semi[label[u]] {
+				label[u] = label[ancestor[u]]
+			}
+			ancestor[u] = ancestor[ancestor[u]]
+		}
+	}
+	eval := func(v int) int {
+		if ancestor[v] == -1 {
+			return v
+		}
+		compress(v)
+		return label[v]
+	}
+
+	bucket := make([][]int, n)
+	for i := len(vertex) - 1; i >= 2; i-- {
+		w := vertex[i]
+		for _, a := range pred[w] {
+			if number[a.to] == 0 {
+				continue
+			}
+			if u := eval(a.to); semi[u] < semi[w] {
+				semi[w] = semi[u]
+			}
+		}
+		bucket[vertex[semi[w]]] = append(bucket[vertex[semi[w]]], w)
+		ancestor[w] = parent[w]
+
+		for _, v := range bucket[parent[w]] {
+			if u := eval(v); semi[u] < semi[v] {
+				idom[v] = u
+			} else {
+				idom[v] = parent[w]
+			}
+		}
+		bucket[parent[w]] = nil
+	}
+
+	for i := 2; i < len(vertex); i++ {
+		if w := vertex[i]; idom[w] != vertex[semi[w]] {
+			idom[w] = idom[idom[w]]
+		}
+	}
+	idom[root] = root
+	return idom
+}
+
+func dominatorsIndexed(gr *graph.Graph, entry graph.TKey, reverse bool) (*indexedGraph, []int, error) {
+	if !gr.Options.IsDirected {
+		return nil, nil, graph.ThrowGraphNotDirected()
+	}
+	if _, err := gr.GetNodeByKey(entry); err != nil {
+		return nil, nil, err
+	}
+
+	ig := makeIndexedGraph(gr)
+	if reverse {
+		return ig, lengauerTarjan(ig.in, ig.out, ig.index[entry]), nil
+	}
+	return ig, lengauerTarjan(ig.out, ig.in, ig.index[entry]), nil
+}
+
+func idomToKeyMap(ig *indexedGraph, idom []int, root int) map[graph.TKey]graph.TKey {
+	result := make(map[graph.TKey]graph.TKey)
+	for v, d := range idom {
+		if d != -1 && v != root {
+			result[ig.keys[v]] = ig.keys[d]
+		}
+	}
+	return result
+}
+
+// Immediate dominator of every node reachable from entry, except entry
+func Dominators(gr *graph.Graph, entry graph.TKey) (map[graph.TKey]graph.TKey, error) {
+	ig, idom, err := dominatorsIndexed(gr, entry, false)
+	if err != nil {
+		return nil, err
+	}
+	return idomToKeyMap(ig, idom, ig.index[entry]), nil
+}
+
+/*
+ * Post-dominators: p post-dominates v if every path from v to exit goes
+ * through p. These are dominators of the transposed graph rooted at exit;
+ * nodes that cannot reach exit are left out. If graph has several exits,
+ * connect them to one extra node first.
+ */
+
+func PostDominators(gr *graph.Graph, exit graph.TKey) (map[graph.TKey]graph.TKey, error) {
+	ig, idom, err := dominatorsIndexed(gr, exit, true)
+	if err != nil {
+		return nil, err
+	}
+	return idomToKeyMap(ig, idom, ig.index[exit]), nil
+}
+
+/*
+ * Dominator tree as RootedTree: parent of every node is its immediate
+ * dominator. Post-dominator tree is the same with reverse flag set and exit
+ * as root.
+ */
+
+func DominatorTree(gr *graph.Graph, root graph.TKey, reverse bool) (*RootedTree, error) {
+	ig, idom, err := dominatorsIndexed(gr, root, reverse)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := &RootedTree{
+		Root:     root,
+		Parent:   idomToKeyMap(ig, idom, ig.index[root]),
+		Children: make(map[graph.TKey][]graph.TKey),
+		Depth:    map[graph.TKey]int{root: 0},
+		Order:    []graph.TKey{root},
+	}
+	for v, d := range idom {
+		if d != -1 {
+			tree.Children[ig.keys[v]] = []graph.TKey{}
+		}
+	}
+	for v, d := range idom {
+		if d != -1 && v != d {
+			tree.Children[ig.keys[d]] = append(tree.Children[ig.keys[d]], ig.keys[v])
+		}
+	}
+	for head := 0; head < len(tree.Order); head++ {
+		key := tree.Order[head]
+		slices.Sort(tree.Children[key])
+		for _, child := range tree.Children[key] {
+			tree.Depth[child] = tree.Depth[key] + 1
+			tree.Order = append(tree.Order, child)
+		}
+	}
+	return tree, nil
+}
+
+/*
+ * Dominance frontier of d: nodes v where domination of d ends, i.e. d
+ * dominates a predecessor of v, but does not strictly dominate v. These are
+ * the places for phi-functions in SSA. Computed by Cooper, Harvey and
+ * Kennedy: from every predecessor of node walk up the dominator tree until
+ * its immediate dominator. Entry has none, so the walk goes through it, and
+ * entry gets into its own frontier if some edge leads back to it.
+ */
+
+func DominanceFrontiers(gr *graph.Graph, entry graph.TKey) (map[graph.TKey][]graph.TKey, error) {
+	ig, idom, err := dominatorsIndexed(gr, entry, false)
+	if err != nil {
+		return nil, err
+	}
+
+	frontier := make([]map[int]bool, len(ig.keys))
+	for v, d := range idom {
+		if d == -1 {
+			continue
+		}
+		frontier[v] = make(map[int]bool)
+	}
+	// Parent in dominator tree, -1 for root
+	up := func(v int) int {
+		if idom[v] == v {
+			return -1
+		}
+		return idom[v]
+	}
+	for v, d := range idom {
+		if d == -1 {
+			continue
+		}
+		for _, a := range ig.in[v] {
+			if idom[a.to] == -1 {
+				continue
+			}
+			for runner := a.to; runner != up(v); runner = up(runner) {
+				frontier[runner][v] = true
+			}
+		}
+	}
+
+	result := make(map[graph.TKey][]graph.TKey)
+	for v, nodes := range frontier {
+		if nodes == nil {
+			continue
+		}
+		keys := make([]graph.TKey, 0, len(nodes))
+		for u := range nodes {
+			keys = append(keys, ig.keys[u])
+		}
+		slices.Sort(keys)
+		result[ig.keys[v]] = keys
+	}
+	return result, nil
+}
+
+/*
+ * Natural loops. Edge t -> h is a back edge if h dominates t; its loop is h
+ * with all nodes that reach t without passing h. Loops sharing a header are
+ * merged into one. Loops are sorted by header, nodes and back edges by key.
+ */
+
+type NaturalLoop struct {
+	Header    graph.TKey
+	BackEdges []graph.TKey
+	Nodes     []graph.TKey
+}
+
+func NaturalLoops(gr *graph.Graph, entry graph.TKey) ([]NaturalLoop, error) {
+	ig, idom, err := dominatorsIndexed(gr, entry, false)
+	if err != nil {
+		return nil, err
+	}
+
+	dominates := func(d, v int) bool {
+		for ; v != idom[v]; v = idom[v] {
+			if v == d {
+				return true
+			}
+		}
+		return v == d
+	}
+
+	loops := make(map[int]*NaturalLoop)
+	bodies := make(map[int]map[int]bool)
+	for t := range ig.keys {
+		if idom[t] == -1 {
+			continue
+		}
+		for _, a := range ig.out[t] {
+			h := a.to
+			if !dominates(h, t) {
+				continue
+			}
+			if loops[h] == nil {
+				loops[h] = &NaturalLoop{Header: ig.keys[h]}
+				bodies[h] = map[int]bool{h: true}
+			}
+			loops[h].BackEdges = append(loops[h].BackEdges, a.edge)
+
+			// Walk predecessors back from t, header stops the walk
+			body := bodies[h]
+			stack := []int{}
+			if !body[t] {
+				body[t] = true
+				stack = append(stack, t)
+			}
+			for len(stack) > 0 {
+				u := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				for _, p := range ig.in[u] {
+					if idom[p.to] != -1 && !body[p.to] {
+						body[p.to] = true
+						stack = append(stack, p.to)
+					}
+				}
+			}
+		}
+	}
+
+	result := make([]NaturalLoop, 0, len(loops))
+	for h, loop := range loops {
+		for u := range bodies[h] {
+			loop.Nodes = append(loop.Nodes, ig.keys[u])
+		}
+		slices.Sort(loop.Nodes)
+		slices.Sort(loop.BackEdges)
+		result = append(result, *loop)
+	}
+	slices.SortFunc(result, func(a, b NaturalLoop) int { return cmp.Compare(a.Header, b.Header) })
+	return result, nil
+}
@@ -0,0 +1,283 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"math"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Eulerian paths and circuits. Parallel edges matter a lot here, so paths
+ * are returned as ordered edge keys, not node keys. Works for directed and
+ * undirected (multi)graphs; undirected self-loop adds 2 to node degree.
+ *
+ * Graph has Eulerian circuit if all edges are in one connected component
+ * and every node is balanced: even degree for undirected graphs, equal in- and
+ * out-degree for directed ones. For Eulerian path exactly two nodes may be
+ * unbalanced: two odd nodes, or one node with out = in + 1 (start) and one
+ * with in = out + 1 (end).
+ */
+
+// Returns start of Eulerian path/circuit, or false if there is none
+func eulerianStart(gr *graph.Graph, circuit bool) (graph.TKey, bool) {
+	if !edgesConnected(gr) {
+		return 0, false
+	}
+
+	balance := make(map[graph.TKey]int, len(gr.Nodes))
+	for _, edge := range gr.Edges {
+		if gr.Options.IsDirected {
+			balance[edge.Source]++
+			balance[edge.Destination]--
+		} else {
+			balance[edge.Source]++
+			balance[edge.Destination]++
+		}
+	}
+
+	var start graph.TKey
+	hasStart, unbalanced := false, 0
+	for _, key := range sortedNodeKeys(gr) {
+		odd := balance[key] != 0
+		if !gr.Options.IsDirected {
+			odd = balance[key]%2 != 0
+		}
+		if !odd {
+			continue
+		}
+
+		unbalanced++
+		if gr.Options.IsDirected && (balance[key] > 1 || balance[key] < -1) {
+			return 0, false
+		}
+		if !hasStart && (!gr.Options.IsDirected || balance[key] == 1) {
+			start, hasStart = key, true
+		}
+	}
+
+	switch {
+	case unbalanced == 0:
+		if keys := sortedEdgeKeys(gr); len(keys) > 0 {
+			return gr.Edges[keys[0]].Source, true
+		}
+		return 0, true
+	case unbalanced == 2 && !circuit:
+		return start, true
+	}
+	return 0, false
+}
+
+// Are all nodes having edges in one (weakly) connected component?
+func edgesConnected(gr *graph.Graph) bool {
+	membership := WeaklyConnectedComponents(gr)
+	component := 0
+	for _, edge := range gr.Edges {
+		if component == 0 {
+			component = membership[edge.Source]
+		}
+		if membership[edge.Source] != component {
+			return false
+		}
+	}
+	return true
+}
+
+func HasEulerianCircuit(gr *graph.Graph) bool {
+	_, ok := eulerianStart(gr, true)
+	return ok
+}
+
+func HasEulerianPath(gr *graph.Graph) bool {
+	_, ok := eulerianStart(gr, false)
+	return ok
+}
+
+/*
+ * Hierholzer's algorithm. Every graph edge is used exactly once, returned as
+ * ordered edge keys. Graph without edges has empty circuit.
+ */
+
+func EulerianCircuit(gr *graph.Graph) ([]graph.TKey, error) {
+	start, ok := eulerianStart(gr, true)
+	if !ok {
+		return nil, graph.ThrowNoEulerianCircuit()
+	}
+	return hierholzer(walkEdgesOf(gr), gr.Options.IsDirected, start), nil
+}
+
+func EulerianPath(gr *graph.Graph) ([]graph.TKey, error) {
+	start, ok := eulerianStart(gr, false)
+	if !ok {
+		return nil, graph.ThrowNoEulerianPath()
+	}
+	return hierholzer(walkEdgesOf(gr), gr.Options.IsDirected, start), nil
+}
+
+/*
+ * Hierholzer works on a plain list of edges, so the same graph edge can be
+ * walked several times (Chinese postman duplicates edges this way)
+ */
+
+type walkEdge struct {
+	key, src, dst graph.TKey
+}
+
+func walkEdgesOf(gr *graph.Graph) []walkEdge {
+	edges := make([]walkEdge, 0, len(gr.Edges))
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		edges = append(edges, walkEdge{key: key, src: edge.Source, dst: edge.Destination})
+	}
+	return edges
+}
+
+func hierholzer(edges []walkEdge, directed bool, start graph.TKey) []graph.TKey {
+	type walkArc struct {
+		id int
+		to graph.TKey
+	}
+	arcs := make(map[graph.TKey][]walkArc)
+	for id, edge := range edges {
+		arcs[edge.src] = append(arcs[edge.src], walkArc{id: id, to: edge.dst})
+		if !directed {
+			arcs[edge.dst] = append(arcs[edge.dst], walkArc{id: id, to: edge.src})
+		}
+	}
+
+	used := make([]bool, len(edges))
+	next := make(map[graph.TKey]int)
+	type frame struct {
+		node graph.TKey
+		edge int // edge id we came by, -1 for start
+	}
+
+	path := make([]graph.TKey, 0, len(edges))
+	stack := []frame{{node: start, edge: -1}}
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		for next[top.node] < len(arcs[top.node]) && used[arcs[top.node][next[top.node]].id] {
+			next[top.node]++
+		}
+		if next[top.node] < len(arcs[top.node]) {
+			a := arcs[top.node][next[top.node]]
+			used[a.id] = true
+			stack = append(stack, frame{node: a.to, edge: a.id})
+			continue
+		}
+		stack = stack[:len(stack)-1]
+		if top.edge != -1 {
+			path = append(path, edges[top.edge].key)
+		}
+	}
+
+	slices.Reverse(path)
+	return path
+}
+
+/*
+ * Chinese postman (route inspection) for weighted undirected graph: the
+ * shortest closed walk using every edge at least once. Odd nodes are paired
+ * up by minimum-weight perfect matching on shortest path distances, edges of
+ * those paths are walked twice, and the route is an Eulerian circuit of the
+ * result.
+ *
+ * Matching is exact (DP over subsets), so the number of odd nodes is limited
+ * by PostmanOddNodesLimit.
+ */
+
+const PostmanOddNodesLimit = 20
+
+type PostmanRoute struct {
+	Edges      []graph.TKey // route as edge keys, repeated edges appear several times
+	Duplicated []graph.TKey // edges walked more than once (one entry per extra walk)
+	Cost       graph.TWeight
+}
+
+func ChinesePostman(gr *graph.Graph) (*PostmanRoute, error) {
+	if gr.Options.IsDirected {
+		return nil, graph.ThrowGraphDirected()
+	}
+	if !edgesConnected(gr) {
+		return nil, graph.ThrowGraphNotConnected()
+	}
+
+	degree := make(map[graph.TKey]int)
+	for _, edge := range gr.Edges {
+		degree[edge.Source]++
+		degree[edge.Destination]++
+	}
+	odd := []graph.TKey{}
+	for _, key := range sortedNodeKeys(gr) {
+		if degree[key]%2 != 0 {
+			odd = append(odd, key)
+		}
+	}
+	if len(odd) > PostmanOddNodesLimit {
+		return nil, graph.ThrowTooManyNodes(len(odd), PostmanOddNodesLimit)
+	}
+
+	arcs := outArcs(gr)
+	dist := make([]map[graph.TKey]graph.TWeight, len(odd))
+	prev := make([]map[graph.TKey]arc, len(odd))
+	for i, key := range odd {
+		dist[i], prev[i] = dijkstra(gr, arcs, key)
+	}
+
+	// best[mask] is the cheapest pairing of odd nodes in mask; always pair
+	// the lowest node of mask to keep DP at O(2^k * k)
+	k := len(odd)
+	best := make([]graph.TWeight, 1<<k)
+	choice := make([]int, 1<<k)
+	for mask := 1; mask < 1<<k; mask++ {
+		best[mask] = math.MaxUint64
+		first := 0
+		for mask&(1<<first) == 0 {
+			first++
+		}
+		for second := first + 1; second < k; second++ {
+			if mask&(1<<second) == 0 {
+				continue
+			}
+			rest := mask &^ (1 << first) &^ (1 << second)
+			if best[rest] == math.MaxUint64 {
+				continue
+			}
+			if cost := best[rest] + dist[first][odd[second]]; cost < best[mask] {
+				best[mask], choice[mask] = cost, second
+			}
+		}
+	}
+
+	route := &PostmanRoute{}
+	edges := walkEdgesOf(gr)
+	for mask := 1<<k - 1; mask != 0; {
+		first := 0
+		for mask&(1<<first) == 0 {
+			first++
+		}
+		second := choice[mask]
+		for node := odd[second]; node != odd[first]; node = prev[first][node].To {
+			key := prev[first][node].Edge
+			edge := gr.Edges[key]
+			route.Duplicated = append(route.Duplicated, key)
+			edges = append(edges, walkEdge{key: key, src: edge.Source, dst: edge.Destination})
+		}
+		mask = mask &^ (1 << first) &^ (1 << second)
+	}
+	slices.Sort(route.Duplicated)
+
+	var start graph.TKey
+	if len(edges) > 0 {
+		start = edges[0].src
+	}
+	route.Edges = hierholzer(edges, false, start)
+	for _, key := range route.Edges {
+		route.Cost += gr.Edges[key].Weight
+	}
+	return route, nil
+}
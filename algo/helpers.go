@@ -0,0 +1,321 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Helpers shared by the algorithms in this package.
+ *
+ * Go maps are iterated in random order, so every algorithm walks nodes and
+ * edges through sorted key slices. This way results (component numbers, DFS
+ * orders, etc.) are reproducible between runs.
+ */
+
+func sortedNodeKeys(gr *graph.Graph) []graph.TKey {
+	keys := make([]graph.TKey, 0, len(gr.Nodes))
+	for key := range gr.Nodes {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func sortedEdgeKeys(gr *graph.Graph) []graph.TKey {
+	keys := make([]graph.TKey, 0, len(gr.Edges))
+	for key := range gr.Edges {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+/*
+ * Graph.AdjacencyMap only stores neighbour keys, which is not enough for
+ * multigraphs: two parallel edges look the same there. So algorithms that
+ * have to tell edges apart use arcs -- a pair of edge key and the node it
+ * leads to. For undirected graphs every edge gives an arc in both directions.
+ */
+
+type arc struct {
+	Edge graph.TKey
+	To   graph.TKey
+}
+
+func outArcs(gr *graph.Graph) map[graph.TKey][]arc {
+	arcs := make(map[graph.TKey][]arc, len(gr.Nodes))
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		arcs[edge.Source] = append(arcs[edge.Source], arc{Edge: key, To: edge.Destination})
+		if !gr.Options.IsDirected {
+			arcs[edge.Destination] = append(arcs[edge.Destination], arc{Edge: key, To: edge.Source})
+		}
+	}
+	return arcs
+}
+
+func inArcs(gr *graph.Graph) map[graph.TKey][]arc {
+	if !gr.Options.IsDirected {
+		return outArcs(gr)
+	}
+
+	arcs := make(map[graph.TKey][]arc, len(gr.Nodes))
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		arcs[edge.Destination] = append(arcs[edge.Destination], arc{Edge: key, To: edge.Source})
+	}
+	return arcs
+}
+
+// Arcs of both directions, i.e. the underlying undirected view of any graph
+func undirectedArcs(gr *graph.Graph) map[graph.TKey][]arc {
+	arcs := make(map[graph.TKey][]arc, len(gr.Nodes))
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		arcs[edge.Source] = append(arcs[edge.Source], arc{Edge: key, To: edge.Destination})
+		arcs[edge.Destination] = append(arcs[edge.Destination], arc{Edge: key, To: edge.Source})
+	}
+	return arcs
+}
+
+/*
+ * Min-heap of dense node indices by distance for Dijkstra-like searches.
+ * Entries are never updated in place: push the new distance and skip stale
+ * entries on pop.
+ */
+
+type distItem struct {
+	node int
+	dist int64
+}
+
+type distHeap []distItem
+
+func (h distHeap) Len() int           { return len(h) }
+func (h distHeap) Less(i, j int) bool { return h[i].dist < h[j].dist }
+func (h distHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *distHeap) Push(x any)        { *h = append(*h, x.(distItem)) }
+func (h *distHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+/*
+ * Dijkstra over arcs with Edge.Weight as length. Returns distances to all
+ * reachable nodes and the arc leading to the previous node on the shortest
+ * path (arc.To is the previous node), so path can be restored backwards.
+ */
+
+func dijkstra(gr *graph.Graph, arcs map[graph.TKey][]arc, src graph.TKey) (map[graph.TKey]graph.TWeight, map[graph.TKey]arc) {
+	dist := map[graph.TKey]graph.TWeight{src: 0}
+	prev := make(map[graph.TKey]arc)
+	done := make(map[graph.TKey]bool)
+
+	queue := &keyDistHeap{{key: src}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(keyDist)
+		if done[item.key] {
+			continue
+		}
+		done[item.key] = true
+		for _, a := range arcs[item.key] {
+			next := item.dist + gr.Edges[a.Edge].Weight
+			if current, seen := dist[a.To]; !seen || next < current {
+				dist[a.To] = next
+				prev[a.To] = arc{Edge: a.Edge, To: item.key}
+				heap.Push(queue, keyDist{key: a.To, dist: next})
+			}
+		}
+	}
+
+	return dist, prev
+}
+
+type keyDist struct {
+	key  graph.TKey
+	dist graph.TWeight
+}
+
+type keyDistHeap []keyDist
+
+func (h keyDistHeap) Len() int { return len(h) }
+func (h keyDistHeap) Less(i, j int) bool {
+	if h[i].dist != h[j].dist {
+		return h[i].dist < h[j].dist
+	}
+	return h[i].key < h[j].key
+}
+func (h keyDistHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *keyDistHeap) Push(x any)   { *h = append(*h, x.(keyDist)) }
+func (h *keyDistHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
+/*
+ * Neighbours in the simple underlying undirected graph: no directions, no
+ * parallel edges and no self-loops. Lists are sorted by key.
+ */
+
+func simpleNeighbours(gr *graph.Graph) map[graph.TKey][]graph.TKey {
+	sets := make(map[graph.TKey]map[graph.TKey]bool, len(gr.Nodes))
+	for _, edge := range gr.Edges {
+		if edge.Source == edge.Destination {
+			continue
+		}
+		for _, pair := range [][2]graph.TKey{{edge.Source, edge.Destination}, {edge.Destination, edge.Source}} {
+			if sets[pair[0]] == nil {
+				sets[pair[0]] = make(map[graph.TKey]bool)
+			}
+			sets[pair[0]][pair[1]] = true
+		}
+	}
+
+	neighbours := make(map[graph.TKey][]graph.TKey, len(gr.Nodes))
+	for key := range gr.Nodes {
+		list := make([]graph.TKey, 0, len(sets[key]))
+		for other := range sets[key] {
+			list = append(list, other)
+		}
+		slices.Sort(list)
+		neighbours[key] = list
+	}
+	return neighbours
+}
+
+/*
+ * Stores per-node results of an algorithm (colours, communities, etc.) as
+ * node attribute, so they are saved to JSON together with graph
+ */
+
+func WriteNodeAttribute(gr *graph.Graph, name string, values map[graph.TKey]int) {
+	for key, value := range values {
+		if node, ok := gr.Nodes[key]; ok {
+			node.UpdateNode(graph.WithNodeAttribute(name, strconv.Itoa(value)))
+		}
+	}
+}
+
+// Same, but replaces node labels, e.g. format "Community %d"
+func WriteNodeLabel(gr *graph.Graph, format string, values map[graph.TKey]int) {
+	for key, value := range values {
+		if node, ok := gr.Nodes[key]; ok {
+			node.UpdateNode(graph.WithNodeLabel(fmt.Sprintf(format, value)))
+		}
+	}
+}
+
+/*
+ * Graph over dense node indices with plain slices instead of maps. Heavy
+ * algorithms (e.g. all-pairs searches) run over it much faster. For
+ * undirected graphs in and out are the same.
+ */
+
+type indexedArc struct {
+	to     int
+	edge   graph.TKey
+	weight graph.TWeight
+}
+
+type indexedGraph struct {
+	keys  []graph.TKey
+	index map[graph.TKey]int
+	out   [][]indexedArc
+	in    [][]indexedArc
+}
+
+func makeIndexedGraph(gr *graph.Graph) *indexedGraph {
+	return buildIndexedGraph(gr, gr.Options.IsDirected)
+}
+
+// The underlying undirected view of any graph
+func makeUndirectedIndexedGraph(gr *graph.Graph) *indexedGraph {
+	return buildIndexedGraph(gr, false)
+}
+
+func buildIndexedGraph(gr *graph.Graph, directed bool) *indexedGraph {
+	ig := &indexedGraph{keys: sortedNodeKeys(gr), index: make(map[graph.TKey]int, len(gr.Nodes))}
+	for i, key := range ig.keys {
+		ig.index[key] = i
+	}
+
+	ig.out = make([][]indexedArc, len(ig.keys))
+	ig.in = ig.out
+	if directed {
+		ig.in = make([][]indexedArc, len(ig.keys))
+	}
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		u, v := ig.index[edge.Source], ig.index[edge.Destination]
+		ig.out[u] = append(ig.out[u], indexedArc{to: v, edge: key, weight: edge.Weight})
+		if directed {
+			ig.in[v] = append(ig.in[v], indexedArc{to: u, edge: key, weight: edge.Weight})
+		} else if u != v {
+			ig.out[v] = append(ig.out[v], indexedArc{to: u, edge: key, weight: edge.Weight})
+		}
+	}
+	return ig
+}
+
+func (ig *indexedGraph) toKeyMap(values []float64) map[graph.TKey]float64 {
+	result := make(map[graph.TKey]float64, len(values))
+	for i, value := range values {
+		result[ig.keys[i]] = value
+	}
+	return result
+}
+
+/*
+ * Distances from src to all nodes, -1 for unreachable ones. BFS if not
+ * weighted, Dijkstra otherwise.
+ */
+
+func (ig *indexedGraph) distances(src int, weighted bool, dist []int64) {
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[src] = 0
+
+	if !weighted {
+		queue := []int{src}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, a := range ig.out[u] {
+				if dist[a.to] == -1 {
+					dist[a.to] = dist[u] + 1
+					queue = append(queue, a.to)
+				}
+			}
+		}
+		return
+	}
+
+	queue := &distHeap{{node: src, dist: 0}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(distItem)
+		if item.dist > dist[item.node] {
+			continue
+		}
+		for _, a := range ig.out[item.node] {
+			next := item.dist + int64(a.weight)
+			if dist[a.to] == -1 || next < dist[a.to] {
+				dist[a.to] = next
+				heap.Push(queue, distItem{node: a.to, dist: next})
+			}
+		}
+	}
+}
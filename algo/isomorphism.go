@@ -0,0 +1,449 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Graph isomorphism and subgraph isomorphism by VF2 (Cordella et al.). Nodes
+ * of pattern are mapped to nodes of graph one pair at a time; a pair is added
+ * only if edges to already mapped nodes agree, and if counts of neighbours in
+ * the "terminal" sets (unmapped nodes next to mapped ones) leave a chance to
+ * complete the mapping.
+ *
+ * Parallel edges and self-loops matter: nodes must be connected by the same
+ * number of edges (or at least the same number, for non-induced subgraphs).
+ * Graphs must both be directed or both undirected.
+ *
+ * By default only the structure is compared. Node and edge predicates (e.g.
+ * MatchNodeLabels, MatchEdgeWeights) make mapped nodes and edges agree too.
+ */
+
+type IsomorphismOptions struct {
+	NodeMatch func(pattern, target *graph.Node) bool
+	EdgeMatch func(pattern, target *graph.Edge) bool
+	Induced   bool // for subgraphs: non-edges of pattern must be non-edges of graph
+}
+
+func makeIsomorphismOptions(options ...graph.Option[IsomorphismOptions]) IsomorphismOptions {
+	opts := IsomorphismOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+	return opts
+}
+
+func WithNodeMatch(match func(pattern, target *graph.Node) bool) graph.Option[IsomorphismOptions] {
+	return func(opts *IsomorphismOptions) {
+		opts.NodeMatch = match
+	}
+}
+
+func WithEdgeMatch(match func(pattern, target *graph.Edge) bool) graph.Option[IsomorphismOptions] {
+	return func(opts *IsomorphismOptions) {
+		opts.EdgeMatch = match
+	}
+}
+
+func WithInduced(induced bool) graph.Option[IsomorphismOptions] {
+	return func(opts *IsomorphismOptions) {
+		opts.Induced = induced
+	}
+}
+
+func MatchNodeLabels(pattern, target *graph.Node) bool {
+	return pattern.Label == target.Label
+}
+
+func MatchEdgeLabels(pattern, target *graph.Edge) bool {
+	return pattern.Label == target.Label
+}
+
+func MatchEdgeWeights(pattern, target *graph.Edge) bool {
+	return pattern.Weight == target.Weight
+}
+
+/*
+ * Isomorphism of g1 and g2. Returns mapping from g1 keys to g2 keys, or false
+ * if graphs are not isomorphic. Induced option is meaningless here.
+ */
+
+func Isomorphism(g1, g2 *graph.Graph, options ...graph.Option[IsomorphismOptions]) (map[graph.TKey]graph.TKey, bool) {
+	if g1.Options.IsDirected != g2.Options.IsDirected || len(g1.Nodes) != len(g2.Nodes) || len(g1.Edges) != len(g2.Edges) {
+		return nil, false
+	}
+	if CanonicalHash(g1) != CanonicalHash(g2) {
+		return nil, false
+	}
+
+	var mapping map[graph.TKey]graph.TKey
+	newVF2(g2, g1, vf2Isomorphism, makeIsomorphismOptions(options...)).search(func(found map[graph.TKey]graph.TKey) bool {
+		mapping = found
+		return false
+	})
+	return mapping, mapping != nil
+}
+
+/*
+ * Occurrence of pattern in gr: mapping from pattern keys to gr keys. Without
+ * WithInduced(true) graph may have extra edges between mapped nodes
+ * (monomorphism), with it edges must be exactly the same.
+ */
+
+func SubgraphIsomorphism(gr, pattern *graph.Graph, options ...graph.Option[IsomorphismOptions]) (map[graph.TKey]graph.TKey, bool) {
+	mappings := AllSubgraphIsomorphisms(gr, pattern, 1, options...)
+	if len(mappings) == 0 {
+		return nil, false
+	}
+	return mappings[0], true
+}
+
+// Up to limit occurrences of pattern in gr, limit <= 0 means all of them
+func AllSubgraphIsomorphisms(gr, pattern *graph.Graph, limit int, options ...graph.Option[IsomorphismOptions]) []map[graph.TKey]graph.TKey {
+	mappings := []map[graph.TKey]graph.TKey{}
+	if gr.Options.IsDirected != pattern.Options.IsDirected || len(pattern.Nodes) > len(gr.Nodes) {
+		return mappings
+	}
+
+	opts := makeIsomorphismOptions(options...)
+	mode := vf2Monomorphism
+	if opts.Induced {
+		mode = vf2Induced
+	}
+	newVF2(gr, pattern, mode, opts).search(func(found map[graph.TKey]graph.TKey) bool {
+		mappings = append(mappings, found)
+		return limit <= 0 || len(mappings) < limit
+	})
+	return mappings
+}
+
+type vf2Mode int
+
+const (
+	vf2Isomorphism vf2Mode = iota
+	vf2Induced
+	vf2Monomorphism
+)
+
+// Graph by dense indices with edges between every ordered pair of nodes
+type vf2Graph struct {
+	keys  []graph.TKey
+	nodes []*graph.Node
+	out   []map[int][]*graph.Edge
+	in    []map[int][]*graph.Edge // the same as out for undirected graphs
+}
+
+func makeVF2Graph(gr *graph.Graph) *vf2Graph {
+	vg := &vf2Graph{keys: sortedNodeKeys(gr)}
+	index := make(map[graph.TKey]int, len(vg.keys))
+	vg.nodes = make([]*graph.Node, len(vg.keys))
+	vg.out = make([]map[int][]*graph.Edge, len(vg.keys))
+	vg.in = vg.out
+	if gr.Options.IsDirected {
+		vg.in = make([]map[int][]*graph.Edge, len(vg.keys))
+	}
+	for i, key := range vg.keys {
+		index[key] = i
+		vg.nodes[i] = gr.Nodes[key]
+		vg.out[i] = make(map[int][]*graph.Edge)
+		vg.in[i] = make(map[int][]*graph.Edge)
+	}
+
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		u, v := index[edge.Source], index[edge.Destination]
+		vg.out[u][v] = append(vg.out[u][v], edge)
+		if gr.Options.IsDirected {
+			vg.in[v][u] = append(vg.in[v][u], edge)
+		} else if u != v {
+			vg.out[v][u] = append(vg.out[v][u], edge)
+		}
+	}
+	return vg
+}
+
+type vf2State struct {
+	g1, g2       *vf2Graph // g1 is the target graph, g2 is the pattern
+	mode         vf2Mode
+	opts         IsomorphismOptions
+	core1, core2 []int // mapped node of other graph, or -1
+	in1, out1    []int // depth at which node entered terminal set, 0 if not yet
+	in2, out2    []int
+	depth        int
+}
+
+func newVF2(target, pattern *graph.Graph, mode vf2Mode, opts IsomorphismOptions) *vf2State {
+	s := &vf2State{g1: makeVF2Graph(target), g2: makeVF2Graph(pattern), mode: mode, opts: opts}
+	n1, n2 := len(s.g1.keys), len(s.g2.keys)
+	s.core1, s.in1, s.out1 = make([]int, n1), make([]int, n1), make([]int, n1)
+	s.core2, s.in2, s.out2 = make([]int, n2), make([]int, n2), make([]int, n2)
+	for i := range s.core1 {
+		s.core1[i] = -1
+	}
+	for i := range s.core2 {
+		s.core2[i] = -1
+	}
+	return s
+}
+
+// Calls visit for every complete mapping until visit returns false
+func (s *vf2State) search(visit func(map[graph.TKey]graph.TKey) bool) bool {
+	if s.depth == len(s.g2.keys) {
+		mapping := make(map[graph.TKey]graph.TKey, s.depth)
+		for b, a := range s.core2 {
+			mapping[s.g2.keys[b]] = s.g1.keys[a]
+		}
+		return visit(mapping)
+	}
+
+	b, candidates := s.candidates()
+	for _, a := range candidates {
+		if !s.feasible(a, b) {
+			continue
+		}
+		s.push(a, b)
+		more := s.search(visit)
+		s.pop(a, b)
+		if !more {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+ * Next pattern node and nodes of graph it may go to. Pattern node is the
+ * smallest unmapped one in out-terminal set, then in in-terminal set, then
+ * any unmapped; graph candidates come from the same kind of set.
+ */
+
+func (s *vf2State) candidates() (int, []int) {
+	pick := func(terminal2, terminal1 []int) (int, []int, bool) {
+		for b := range s.core2 {
+			if s.core2[b] != -1 || terminal2 != nil && terminal2[b] == 0 {
+				continue
+			}
+			candidates := []int{}
+			for a := range s.core1 {
+				if s.core1[a] == -1 && (terminal1 == nil || terminal1[a] != 0) {
+					candidates = append(candidates, a)
+				}
+			}
+			return b, candidates, true
+		}
+		return 0, nil, false
+	}
+
+	if b, candidates, ok := pick(s.out2, s.out1); ok {
+		return b, candidates
+	}
+	if b, candidates, ok := pick(s.in2, s.in1); ok {
+		return b, candidates
+	}
+	b, candidates, _ := pick(nil, nil)
+	return b, candidates
+}
+
+// Edges between mapped pair of pattern nodes fit edges between graph nodes
+func (s *vf2State) edgesFit(target, pattern []*graph.Edge) bool {
+	if s.mode == vf2Monomorphism && len(target) < len(pattern) || s.mode != vf2Monomorphism && len(target) != len(pattern) {
+		return false
+	}
+	if s.opts.EdgeMatch == nil || len(pattern) == 0 {
+		return true
+	}
+
+	// Parallel edges may be matched in any order: bipartite matching
+	owner := make([]int, len(target))
+	for i := range owner {
+		owner[i] = -1
+	}
+	var augment func(p int, seen []bool) bool
+	augment = func(p int, seen []bool) bool {
+		for t := range target {
+			if seen[t] || !s.opts.EdgeMatch(pattern[p], target[t]) {
+				continue
+			}
+			seen[t] = true
+			if owner[t] == -1 || augment(owner[t], seen) {
+				owner[t] = p
+				return true
+			}
+		}
+		return false
+	}
+	for p := range pattern {
+		if !augment(p, make([]bool, len(target))) {
+			return false
+		}
+	}
+	return true
+}
+
+// Numbers of unmapped neighbours in in-terminal, out-terminal and neither set
+func terminalCounts(adj map[int][]*graph.Edge, self int, core, in, out []int) [3]int {
+	var counts [3]int
+	for n := range adj {
+		if n == self || core[n] != -1 {
+			continue
+		}
+		if in[n] != 0 {
+			counts[0]++
+		}
+		if out[n] != 0 {
+			counts[1]++
+		}
+		if in[n] == 0 && out[n] == 0 {
+			counts[2]++
+		}
+	}
+	return counts
+}
+
+func (s *vf2State) feasible(a, b int) bool {
+	if s.opts.NodeMatch != nil && !s.opts.NodeMatch(s.g2.nodes[b], s.g1.nodes[a]) {
+		return false
+	}
+	if !s.edgesFit(s.g1.out[a][a], s.g2.out[b][b]) {
+		return false
+	}
+
+	// Every edge of pattern to mapped nodes must exist in graph
+	for _, side := range []struct{ adj1, adj2 map[int][]*graph.Edge }{{s.g1.out[a], s.g2.out[b]}, {s.g1.in[a], s.g2.in[b]}} {
+		for m2, edges := range side.adj2 {
+			if m1 := s.core2[m2]; m2 != b && m1 != -1 && !s.edgesFit(side.adj1[m1], edges) {
+				return false
+			}
+		}
+		// And for exact modes, every edge of graph between mapped nodes too
+		if s.mode == vf2Monomorphism {
+			continue
+		}
+		for m1 := range side.adj1 {
+			if m2 := s.core1[m1]; m1 != a && m2 != -1 && len(side.adj2[m2]) == 0 {
+				return false
+			}
+		}
+	}
+
+	// Look-ahead: graph node must have enough neighbours in terminal sets
+	for _, side := range []struct{ adj1, adj2 map[int][]*graph.Edge }{{s.g1.out[a], s.g2.out[b]}, {s.g1.in[a], s.g2.in[b]}} {
+		c1 := terminalCounts(side.adj1, a, s.core1, s.in1, s.out1)
+		c2 := terminalCounts(side.adj2, b, s.core2, s.in2, s.out2)
+		switch s.mode {
+		case vf2Isomorphism:
+			if c1 != c2 {
+				return false
+			}
+		case vf2Induced:
+			if c1[0] < c2[0] || c1[1] < c2[1] || c1[2] < c2[2] {
+				return false
+			}
+		case vf2Monomorphism:
+			if c1[0] < c2[0] || c1[1] < c2[1] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (s *vf2State) push(a, b int) {
+	s.depth++
+	s.core1[a], s.core2[b] = b, a
+	enter := func(g *vf2Graph, node int, in, out []int) {
+		if in[node] == 0 {
+			in[node] = s.depth
+		}
+		if out[node] == 0 {
+			out[node] = s.depth
+		}
+		for n := range g.out[node] {
+			if out[n] == 0 {
+				out[n] = s.depth
+			}
+		}
+		for n := range g.in[node] {
+			if in[n] == 0 {
+				in[n] = s.depth
+			}
+		}
+	}
+	enter(s.g1, a, s.in1, s.out1)
+	enter(s.g2, b, s.in2, s.out2)
+}
+
+func (s *vf2State) pop(a, b int) {
+	for _, terminal := range [][]int{s.in1, s.out1, s.in2, s.out2} {
+		for i := range terminal {
+			if terminal[i] == s.depth {
+				terminal[i] = 0
+			}
+		}
+	}
+	s.core1[a], s.core2[b] = -1, -1
+	s.depth--
+}
+
+/*
+ * Structural hash by Weisfeiler-Lehman refinement: every node starts with
+ * its in- and out-degree, then WLHashIterations times takes a new label from
+ * its own label and sorted labels of neighbours. Hash is taken from sorted
+ * final labels, so it does not depend on node keys.
+ *
+ * Isomorphic graphs always have equal hashes, so different hashes prove
+ * graphs are different. Equal hashes do not prove isomorphism (e.g. regular
+ * graphs of the same size often collide).
+ */
+
+const WLHashIterations = 3
+
+func CanonicalHash(gr *graph.Graph) string {
+	vg := makeVF2Graph(gr)
+	n := len(vg.keys)
+
+	labels := make([]string, n)
+	for i := range n {
+		in, out := 0, 0
+		for _, edges := range vg.in[i] {
+			in += len(edges)
+		}
+		for _, edges := range vg.out[i] {
+			out += len(edges)
+		}
+		labels[i] = fmt.Sprintf("%d/%d", in, out)
+	}
+
+	next := make([]string, n)
+	for range WLHashIterations {
+		for i := range n {
+			neighbours := []string{}
+			for j, edges := range vg.out[i] {
+				neighbours = append(neighbours, fmt.Sprintf(">%s*%d", labels[j], len(edges)))
+			}
+			if gr.Options.IsDirected {
+				for j, edges := range vg.in[i] {
+					neighbours = append(neighbours, fmt.Sprintf("<%s*%d", labels[j], len(edges)))
+				}
+			}
+			slices.Sort(neighbours)
+			sum := sha256.Sum256([]byte(labels[i] + "|" + strings.Join(neighbours, ",")))
+			next[i] = hex.EncodeToString(sum[:8])
+		}
+		labels, next = next, labels
+	}
+
+	slices.Sort(labels)
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%v|%d|%d|%s", gr.Options.IsDirected, n, len(gr.Edges), strings.Join(labels, ","))))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,294 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Bipartiteness and matchings. Everything here works on the underlying
+ * undirected graph, so edge directions are ignored.
+ */
+
+/*
+ * Two-colouring of graph by BFS. If graph is bipartite, returns colours (0 or
+ * 1) for every node and nil cycle. Otherwise returns nil colours and an odd
+ * cycle as a witness: edge keys in order of traversal.
+ */
+
+func BipartiteColoring(gr *graph.Graph) (map[graph.TKey]int, []graph.TKey) {
+	arcs := undirectedArcs(gr)
+	color := make(map[graph.TKey]int, len(gr.Nodes))
+	depth := make(map[graph.TKey]int, len(gr.Nodes))
+	parent := make(map[graph.TKey]arc, len(gr.Nodes)) // arc leading to the parent
+
+	for _, root := range sortedNodeKeys(gr) {
+		if _, seen := color[root]; seen {
+			continue
+		}
+
+		color[root] = 0
+		queue := []graph.TKey{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			for _, a := range arcs[node] {
+				if _, seen := color[a.To]; !seen {
+					color[a.To] = 1 - color[node]
+					depth[a.To] = depth[node] + 1
+					parent[a.To] = arc{Edge: a.Edge, To: node}
+					queue = append(queue, a.To)
+				} else if color[a.To] == color[node] {
+					return nil, oddCycle(parent, depth, node, a)
+				}
+			}
+		}
+	}
+
+	return color, nil
+}
+
+// Builds cycle from conflicting edge and both tree paths up to common ancestor
+func oddCycle(parent map[graph.TKey]arc, depth map[graph.TKey]int, node graph.TKey, conflict arc) []graph.TKey {
+	up, down := []graph.TKey{}, []graph.TKey{}
+	u, v := node, conflict.To
+	for u != v {
+		if depth[u] >= depth[v] {
+			up = append(up, parent[u].Edge)
+			u = parent[u].To
+		} else {
+			down = append(down, parent[v].Edge)
+			v = parent[v].To
+		}
+	}
+
+	// From node up to ancestor, down to conflict.To and back by conflict edge
+	slices.Reverse(down)
+	cycle := append(up, down...)
+	return append(cycle, conflict.Edge)
+}
+
+func IsBipartite(gr *graph.Graph) bool {
+	color, _ := BipartiteColoring(gr)
+	return color != nil
+}
+
+/*
+ * Maximum matching in bipartite graph via Hopcroft-Karp: BFS layers free
+ * left nodes, then DFS finds maximal set of shortest augmenting paths.
+ * O(E * sqrt(V)). Returns matched edge keys
+ */
+
+func HopcroftKarp(gr *graph.Graph) ([]graph.TKey, error) {
+	color, _ := BipartiteColoring(gr)
+	if color == nil {
+		return nil, graph.ThrowGraphNotBipartite()
+	}
+
+	arcs := undirectedArcs(gr)
+	left := []graph.TKey{}
+	for _, key := range sortedNodeKeys(gr) {
+		if color[key] == 0 {
+			left = append(left, key)
+		}
+	}
+
+	const free = -1
+	matchEdge := make(map[graph.TKey]graph.TKey) // node to its matched edge
+	mate := make(map[graph.TKey]graph.TKey)
+	dist := make(map[graph.TKey]int, len(left))
+
+	bfs := func() bool {
+		queue := []graph.TKey{}
+		for _, u := range left {
+			if _, matched := mate[u]; !matched {
+				dist[u] = 0
+				queue = append(queue, u)
+			} else {
+				dist[u] = free
+			}
+		}
+
+		found := false
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, a := range arcs[u] {
+				next, matched := mate[a.To]
+				if !matched {
+					found = true
+				} else if dist[next] == free {
+					dist[next] = dist[u] + 1
+					queue = append(queue, next)
+				}
+			}
+		}
+		return found
+	}
+
+	var dfs func(u graph.TKey) bool
+	dfs = func(u graph.TKey) bool {
+		for _, a := range arcs[u] {
+			next, matched := mate[a.To]
+			if !matched || dist[next] == dist[u]+1 && dfs(next) {
+				mate[u], mate[a.To] = a.To, u
+				matchEdge[u], matchEdge[a.To] = a.Edge, a.Edge
+				return true
+			}
+		}
+		dist[u] = free
+		return false
+	}
+
+	for bfs() {
+		for _, u := range left {
+			if _, matched := mate[u]; !matched {
+				dfs(u)
+			}
+		}
+	}
+
+	matching := []graph.TKey{}
+	for _, u := range left {
+		if edge, ok := matchEdge[u]; ok {
+			matching = append(matching, edge)
+		}
+	}
+	slices.Sort(matching)
+	return matching, nil
+}
+
+/*
+ * Maximum matching in general graph via Edmonds' blossom algorithm. Odd
+ * cycles (blossoms) met during search of augmenting path are contracted into
+ * their base node. O(V^3). Returns matched edge keys; if nodes are connected
+ * by parallel edges, the one with the smallest key is used.
+ */
+
+func BlossomMatching(gr *graph.Graph) []graph.TKey {
+	keys := sortedNodeKeys(gr)
+	index := make(map[graph.TKey]int, len(keys))
+	for i, key := range keys {
+		index[key] = i
+	}
+
+	n := len(keys)
+	adj := make([][]int, n)
+	edgeBetween := make(map[[2]int]graph.TKey)
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		u, v := index[edge.Source], index[edge.Destination]
+		if u == v {
+			continue
+		}
+		pair := [2]int{min(u, v), max(u, v)}
+		if _, seen := edgeBetween[pair]; seen {
+			continue
+		}
+		edgeBetween[pair] = key
+		adj[u] = append(adj[u], v)
+		adj[v] = append(adj[v], u)
+	}
+
+	match, parent, base := make([]int, n), make([]int, n), make([]int, n)
+	used, blossom := make([]bool, n), make([]bool, n)
+	for i := range match {
+		match[i] = -1
+	}
+
+	lca := func(a, b int) int {
+		seen := make([]bool, n)
+		for {
+			a = base[a]
+			seen[a] = true
+			if match[a] == -1 {
+				break
+			}
+			a = parent[match[a]]
+		}
+		for {
+			b = base[b]
+			if seen[b] {
+				return b
+			}
+			b = parent[match[b]]
+		}
+	}
+
+	markPath := func(v, b, child int) {
+		for base[v] != b {
+			blossom[base[v]], blossom[base[match[v]]] = true, true
+			parent[v] = child
+			child = match[v]
+			v = parent[match[v]]
+		}
+	}
+
+	findPath := func(root int) int {
+		for i := range n {
+			used[i], parent[i], base[i] = false, -1, i
+		}
+		used[root] = true
+		queue := []int{root}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			for _, to := range adj[v] {
+				if base[v] == base[to] || match[v] == to {
+					continue
+				}
+				if to == root || match[to] != -1 && parent[match[to]] != -1 {
+					current := lca(v, to)
+					for i := range blossom {
+						blossom[i] = false
+					}
+					markPath(v, current, to)
+					markPath(to, current, v)
+					for i := range n {
+						if blossom[base[i]] {
+							base[i] = current
+							if !used[i] {
+								used[i] = true
+								queue = append(queue, i)
+							}
+						}
+					}
+				} else if parent[to] == -1 {
+					parent[to] = v
+					if match[to] == -1 {
+						return to
+					}
+					used[match[to]] = true
+					queue = append(queue, match[to])
+				}
+			}
+		}
+		return -1
+	}
+
+	for root := range n {
+		if match[root] != -1 {
+			continue
+		}
+		for v := findPath(root); v != -1; {
+			pv := parent[v]
+			next := match[pv]
+			match[v], match[pv] = pv, v
+			v = next
+		}
+	}
+
+	matching := []graph.TKey{}
+	for u, v := range match {
+		if v > u {
+			matching = append(matching, edgeBetween[[2]int{u, v}])
+		}
+	}
+	slices.Sort(matching)
+	return matching
+}
@@ -0,0 +1,309 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"math"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Maximum flow. Edge.Weight is treated as edge capacity, graph must be
+ * directed. Every edge gets its own pair of residual arcs (forward and
+ * reverse), so parallel and antiparallel edges need no special care: flow on
+ * each of them is tracked separately. Self-loops can carry no flow and are
+ * skipped.
+ *
+ * Result contains flow value, flow on every edge keyed by edge key, and the
+ * minimum s-t cut: nodes reachable from source in residual network, the rest
+ * of nodes, and saturated edges going from the first set to the second one.
+ */
+
+type MaxFlowResult struct {
+	Value      graph.TWeight
+	Flow       map[graph.TKey]graph.TWeight
+	SourceSide []graph.TKey
+	SinkSide   []graph.TKey
+	CutEdges   []graph.TKey
+}
+
+/*
+ * Residual network over dense node indices. Arc i and arc i^1 are the
+ * forward and reverse arcs of the same edge.
+ */
+
+type flowNetwork struct {
+	keys     []graph.TKey
+	index    map[graph.TKey]int
+	head     [][]int
+	to       []int
+	residual []graph.TWeight
+	cost     []int64      // Edge.Cost for forward arc, negated for reverse one
+	edge     []graph.TKey // original edge of forward arc
+}
+
+func makeFlowNetwork(gr *graph.Graph, src, dst graph.TKey) (*flowNetwork, error) {
+	if !gr.Options.IsDirected {
+		return nil, graph.ThrowGraphNotDirected()
+	}
+	if _, err := gr.GetNodeByKey(src); err != nil {
+		return nil, err
+	}
+	if _, err := gr.GetNodeByKey(dst); err != nil {
+		return nil, err
+	}
+	if src == dst {
+		return nil, graph.ThrowSourceIsSink(src)
+	}
+
+	net := &flowNetwork{keys: sortedNodeKeys(gr), index: make(map[graph.TKey]int, len(gr.Nodes))}
+	for i, key := range net.keys {
+		net.index[key] = i
+	}
+	net.head = make([][]int, len(net.keys))
+
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		if edge.Source == edge.Destination {
+			continue
+		}
+		u, v := net.index[edge.Source], net.index[edge.Destination]
+		net.head[u] = append(net.head[u], len(net.to))
+		net.to = append(net.to, v)
+		net.residual = append(net.residual, edge.Weight)
+		net.cost = append(net.cost, int64(edge.Cost))
+		net.edge = append(net.edge, key)
+		net.head[v] = append(net.head[v], len(net.to))
+		net.to = append(net.to, u)
+		net.residual = append(net.residual, 0)
+		net.cost = append(net.cost, -int64(edge.Cost))
+		net.edge = append(net.edge, key)
+	}
+
+	return net, nil
+}
+
+func (net *flowNetwork) result(gr *graph.Graph, s int) *MaxFlowResult {
+	res := &MaxFlowResult{Flow: make(map[graph.TKey]graph.TWeight, len(gr.Edges))}
+	for key := range gr.Edges {
+		res.Flow[key] = 0
+	}
+	for i := 0; i < len(net.to); i += 2 {
+		res.Flow[net.edge[i]] = net.residual[i^1]
+	}
+
+	reachable := make([]bool, len(net.keys))
+	reachable[s] = true
+	queue := []int{s}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, a := range net.head[u] {
+			if net.residual[a] > 0 && !reachable[net.to[a]] {
+				reachable[net.to[a]] = true
+				queue = append(queue, net.to[a])
+			}
+		}
+	}
+
+	for i, key := range net.keys {
+		if reachable[i] {
+			res.SourceSide = append(res.SourceSide, key)
+		} else {
+			res.SinkSide = append(res.SinkSide, key)
+		}
+	}
+	for i := 0; i < len(net.to); i += 2 {
+		if reachable[net.to[i^1]] && !reachable[net.to[i]] {
+			res.CutEdges = append(res.CutEdges, net.edge[i])
+			res.Value += gr.Edges[net.edge[i]].Weight
+		}
+	}
+	return res
+}
+
+/*
+ * Edmonds-Karp: Ford-Fulkerson with shortest (by edge count) augmenting
+ * paths found by BFS. O(V * E^2)
+ */
+
+func MaxFlowEdmondsKarp(gr *graph.Graph, src, dst graph.TKey) (*MaxFlowResult, error) {
+	net, err := makeFlowNetwork(gr, src, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	s, t := net.index[src], net.index[dst]
+	parent := make([]int, len(net.keys))
+	for {
+		for i := range parent {
+			parent[i] = -1
+		}
+		parent[s] = len(net.to)
+		queue := []int{s}
+		for len(queue) > 0 && parent[t] == -1 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, a := range net.head[u] {
+				if net.residual[a] > 0 && parent[net.to[a]] == -1 {
+					parent[net.to[a]] = a
+					queue = append(queue, net.to[a])
+				}
+			}
+		}
+		if parent[t] == -1 {
+			break
+		}
+
+		bottleneck := graph.TWeight(math.MaxUint64)
+		for v := t; v != s; v = net.to[parent[v]^1] {
+			bottleneck = min(bottleneck, net.residual[parent[v]])
+		}
+		for v := t; v != s; v = net.to[parent[v]^1] {
+			net.residual[parent[v]] -= bottleneck
+			net.residual[parent[v]^1] += bottleneck
+		}
+	}
+
+	return net.result(gr, s), nil
+}
+
+/*
+ * Dinic: BFS builds level graph, then DFS pushes blocking flow through it.
+ * O(V^2 * E), much faster on unit capacities
+ */
+
+func MaxFlowDinic(gr *graph.Graph, src, dst graph.TKey) (*MaxFlowResult, error) {
+	net, err := makeFlowNetwork(gr, src, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	s, t := net.index[src], net.index[dst]
+	level := make([]int, len(net.keys))
+	next := make([]int, len(net.keys))
+
+	var push func(u int, limit graph.TWeight) graph.TWeight
+	push = func(u int, limit graph.TWeight) graph.TWeight {
+		if u == t {
+			return limit
+		}
+		for ; next[u] < len(net.head[u]); next[u]++ {
+			a := net.head[u][next[u]]
+			v := net.to[a]
+			if net.residual[a] == 0 || level[v] != level[u]+1 {
+				continue
+			}
+			if pushed := push(v, min(limit, net.residual[a])); pushed > 0 {
+				net.residual[a] -= pushed
+				net.residual[a^1] += pushed
+				return pushed
+			}
+		}
+		return 0
+	}
+
+	for {
+		for i := range level {
+			level[i] = -1
+		}
+		level[s] = 0
+		queue := []int{s}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, a := range net.head[u] {
+				if net.residual[a] > 0 && level[net.to[a]] == -1 {
+					level[net.to[a]] = level[u] + 1
+					queue = append(queue, net.to[a])
+				}
+			}
+		}
+		if level[t] == -1 {
+			break
+		}
+
+		for i := range next {
+			next[i] = 0
+		}
+		for push(s, math.MaxUint64) > 0 {
+		}
+	}
+
+	return net.result(gr, s), nil
+}
+
+/*
+ * Push-relabel (Goldberg-Tarjan) with FIFO selection of active nodes. It
+ * works with preflow instead of augmenting paths: source saturates all its
+ * edges, then excess is pushed downhill and nodes are lifted when stuck.
+ * O(V^3)
+ */
+
+func MaxFlowPushRelabel(gr *graph.Graph, src, dst graph.TKey) (*MaxFlowResult, error) {
+	net, err := makeFlowNetwork(gr, src, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(net.keys)
+	s, t := net.index[src], net.index[dst]
+	height := make([]int, n)
+	excess := make([]graph.TWeight, n)
+	current := make([]int, n)
+	active := []int{}
+
+	height[s] = n
+	for _, a := range net.head[s] {
+		if net.residual[a] == 0 {
+			continue
+		}
+		v := net.to[a]
+		if excess[v] == 0 && v != t {
+			active = append(active, v)
+		}
+		excess[v] += net.residual[a]
+		net.residual[a^1] += net.residual[a]
+		net.residual[a] = 0
+	}
+
+	for len(active) > 0 {
+		u := active[0]
+		active = active[1:]
+
+		for excess[u] > 0 {
+			if current[u] == len(net.head[u]) {
+				// Relabel: lift just above the lowest residual neighbour
+				lowest := math.MaxInt
+				for _, a := range net.head[u] {
+					if net.residual[a] > 0 {
+						lowest = min(lowest, height[net.to[a]])
+					}
+				}
+				height[u] = lowest + 1
+				current[u] = 0
+				continue
+			}
+
+			a := net.head[u][current[u]]
+			v := net.to[a]
+			if net.residual[a] > 0 && height[u] == height[v]+1 {
+				pushed := min(excess[u], net.residual[a])
+				net.residual[a] -= pushed
+				net.residual[a^1] += pushed
+				excess[u] -= pushed
+				if excess[v] == 0 && v != s && v != t {
+					active = append(active, v)
+				}
+				excess[v] += pushed
+			} else {
+				current[u]++
+			}
+		}
+	}
+
+	return net.result(gr, s), nil
+}
@@ -0,0 +1,212 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Structural metrics based on distances. Like centralities, they take
+ * weighted flag: if false, distance is the number of edges (hops), otherwise
+ * the sum of edge weights. Directed graphs are walked along edge directions.
+ * All of them need distances between all pairs, which is one BFS (or
+ * Dijkstra) per node, so they are heavy for big graphs.
+ */
+
+/*
+ * Eccentricity of node is the distance to the farthest node. Diameter and
+ * radius are the maximum and minimum eccentricity, center and periphery are
+ * nodes having eccentricity equal to radius and diameter respectively.
+ *
+ * If some node cannot reach another one, its eccentricity is infinite, so for
+ * disconnected (not strongly connected, if directed) graphs an error is
+ * returned.
+ */
+
+type DistanceStats struct {
+	Eccentricity map[graph.TKey]graph.TWeight
+	Diameter     graph.TWeight
+	Radius       graph.TWeight
+	Center       []graph.TKey
+	Periphery    []graph.TKey
+}
+
+func DistanceMetrics(gr *graph.Graph, weighted bool) (*DistanceStats, error) {
+	_, stats := AllPairsMetrics(gr, weighted)
+	if stats == nil {
+		return nil, graph.ThrowGraphNotConnected()
+	}
+	return stats, nil
+}
+
+/*
+ * Average shortest path and distance stats together from a single all-pairs
+ * pass, which is the expensive part of both. Stats are nil if graph is not
+ * (strongly) connected, the average is defined anyway.
+ */
+
+func AllPairsMetrics(gr *graph.Graph, weighted bool) (float64, *DistanceStats) {
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	stats := &DistanceStats{Eccentricity: make(map[graph.TKey]graph.TWeight, n), Center: []graph.TKey{}, Periphery: []graph.TKey{}}
+	connected := true
+
+	var sum float64
+	pairs := 0
+	dist := make([]int64, n)
+	for v, key := range ig.keys {
+		ig.distances(v, weighted, dist)
+		for u, d := range dist {
+			if u != v && d != -1 {
+				sum += float64(d)
+				pairs++
+			}
+		}
+		if !connected || slices.Contains(dist, -1) {
+			connected = false
+			continue
+		}
+		stats.Eccentricity[key] = graph.TWeight(slices.Max(dist))
+
+		if v == 0 || stats.Eccentricity[key] > stats.Diameter {
+			stats.Diameter = stats.Eccentricity[key]
+		}
+		if v == 0 || stats.Eccentricity[key] < stats.Radius {
+			stats.Radius = stats.Eccentricity[key]
+		}
+	}
+
+	average := 0.0
+	if pairs > 0 {
+		average = sum / float64(pairs)
+	}
+	if !connected {
+		return average, nil
+	}
+
+	for _, key := range ig.keys {
+		if stats.Eccentricity[key] == stats.Radius {
+			stats.Center = append(stats.Center, key)
+		}
+		if stats.Eccentricity[key] == stats.Diameter {
+			stats.Periphery = append(stats.Periphery, key)
+		}
+	}
+	return average, stats
+}
+
+func Eccentricity(gr *graph.Graph, weighted bool) (map[graph.TKey]graph.TWeight, error) {
+	stats, err := DistanceMetrics(gr, weighted)
+	if err != nil {
+		return nil, err
+	}
+	return stats.Eccentricity, nil
+}
+
+func Diameter(gr *graph.Graph, weighted bool) (graph.TWeight, error) {
+	stats, err := DistanceMetrics(gr, weighted)
+	if err != nil {
+		return 0, err
+	}
+	return stats.Diameter, nil
+}
+
+func Radius(gr *graph.Graph, weighted bool) (graph.TWeight, error) {
+	stats, err := DistanceMetrics(gr, weighted)
+	if err != nil {
+		return 0, err
+	}
+	return stats.Radius, nil
+}
+
+func Center(gr *graph.Graph, weighted bool) ([]graph.TKey, error) {
+	stats, err := DistanceMetrics(gr, weighted)
+	if err != nil {
+		return nil, err
+	}
+	return stats.Center, nil
+}
+
+func Periphery(gr *graph.Graph, weighted bool) ([]graph.TKey, error) {
+	stats, err := DistanceMetrics(gr, weighted)
+	if err != nil {
+		return nil, err
+	}
+	return stats.Periphery, nil
+}
+
+/*
+ * Average shortest path length over all ordered pairs of different nodes
+ * with a path between them. Unreachable pairs are skipped, so it is defined
+ * for disconnected graphs as well; graph without such pairs gives 0.
+ */
+
+func AverageShortestPath(gr *graph.Graph, weighted bool) float64 {
+	average, _ := AllPairsMetrics(gr, weighted)
+	return average
+}
+
+/*
+ * Girth is the number of edges in the shortest cycle. Self-loop is a cycle of
+ * length 1, two parallel edges (or two opposite arcs in directed graph) make
+ * a cycle of length 2. Returns false if graph has no cycles.
+ *
+ * BFS from every node: in undirected graph any non-tree edge u-v closes a
+ * cycle not longer than d(u) + d(v) + 1, and for the root lying on the
+ * shortest cycle this is exact. In directed graph arc u -> root closes a
+ * cycle of length d(u) + 1. O(VE).
+ */
+
+func Girth(gr *graph.Graph) (int, bool) {
+	ig := makeIndexedGraph(gr)
+	n := len(ig.keys)
+	girth := 0
+	dist := make([]int, n)
+	parent := make([]graph.TKey, n) // edge leading to BFS parent
+	for root := range n {
+		for i := range dist {
+			dist[i] = -1
+		}
+		dist[root] = 0
+		queue := []int{root}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			// Shortest cycle through root still possible from u
+			bound := 2*dist[u] + 1
+			if gr.Options.IsDirected {
+				bound = dist[u] + 1
+			}
+			if girth != 0 && bound >= girth {
+				break
+			}
+
+			for _, a := range ig.out[u] {
+				length := 0
+				if gr.Options.IsDirected {
+					if a.to == root {
+						length = dist[u] + 1
+					}
+				} else if a.to == u {
+					length = 1
+				} else if dist[a.to] != -1 && (u == root || a.edge != parent[u]) {
+					length = dist[u] + dist[a.to] + 1
+				}
+				if length > 0 && (girth == 0 || length < girth) {
+					girth = length
+				}
+				if dist[a.to] == -1 {
+					dist[a.to] = dist[u] + 1
+					parent[a.to] = a.edge
+					queue = append(queue, a.to)
+				}
+			}
+		}
+	}
+	return girth, girth != 0
+}
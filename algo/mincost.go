@@ -0,0 +1,285 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Minimum-cost maximum flow. Edge.Weight is capacity and Edge.Cost is price
+ * of one unit of flow through the edge. Among all maximum flows the cheapest
+ * one is returned.
+ *
+ * Algorithm is successive shortest paths: augment along the cheapest path in
+ * residual network while one exists. Reverse arcs have negative costs, so
+ * Dijkstra runs on reduced costs cost(u, v) + p(u) - p(v), where p are node
+ * potentials (Johnson's trick). Costs are non-negative initially, so zero
+ * potentials are valid from the start.
+ *
+ * Distances and potentials are int64 and stay below (V + 1)^2 times the
+ * biggest cost, so graph with bigger costs is rejected, as well as total
+ * cost not fitting into TWeight.
+ */
+
+type MinCostFlowResult struct {
+	Value graph.TWeight
+	Cost  graph.TWeight
+	Flow  map[graph.TKey]graph.TWeight
+}
+
+func MinCostMaxFlow(gr *graph.Graph, src, dst graph.TKey) (*MinCostFlowResult, error) {
+	net, err := makeFlowNetwork(gr, src, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(net.keys)
+	limit := graph.TWeight(math.MaxInt64 / ((n + 1) * (n + 1)))
+	for _, key := range sortedEdgeKeys(gr) {
+		if gr.Edges[key].Cost > limit {
+			return nil, graph.ThrowInvalidParameter("cost", fmt.Sprintf("edge %d costs more than %d allowed for %d nodes", key, limit, n))
+		}
+	}
+
+	s, t := net.index[src], net.index[dst]
+	potential := make([]int64, n)
+	dist := make([]int64, n)
+	parent := make([]int, n)
+	res := &MinCostFlowResult{}
+
+	for {
+		for i := range dist {
+			dist[i], parent[i] = math.MaxInt64, -1
+		}
+		dist[s] = 0
+		queue := &distHeap{{node: s}}
+		for queue.Len() > 0 {
+			item := heap.Pop(queue).(distItem)
+			if item.dist > dist[item.node] {
+				continue
+			}
+			u := item.node
+			for _, a := range net.head[u] {
+				v := net.to[a]
+				if net.residual[a] == 0 {
+					continue
+				}
+				reduced := dist[u] + net.cost[a] + potential[u] - potential[v]
+				if reduced < dist[v] {
+					dist[v], parent[v] = reduced, a
+					heap.Push(queue, distItem{node: v, dist: reduced})
+				}
+			}
+		}
+		if dist[t] == math.MaxInt64 {
+			break
+		}
+
+		for i := range potential {
+			potential[i] += min(dist[i], dist[t])
+		}
+
+		bottleneck := graph.TWeight(math.MaxUint64)
+		for v := t; v != s; v = net.to[parent[v]^1] {
+			bottleneck = min(bottleneck, net.residual[parent[v]])
+		}
+		var pathCost int64
+		for v := t; v != s; v = net.to[parent[v]^1] {
+			net.residual[parent[v]] -= bottleneck
+			net.residual[parent[v]^1] += bottleneck
+			pathCost += net.cost[parent[v]]
+		}
+		res.Value += bottleneck
+		high, low := bits.Mul64(uint64(bottleneck), uint64(pathCost))
+		total, carry := bits.Add64(uint64(res.Cost), low, 0)
+		if high != 0 || carry != 0 {
+			return nil, graph.ThrowInvalidParameter("cost", "total cost of flow does not fit into 64 bits")
+		}
+		res.Cost = graph.TWeight(total)
+	}
+
+	res.Flow = make(map[graph.TKey]graph.TWeight, len(gr.Edges))
+	for key := range gr.Edges {
+		res.Flow[key] = 0
+	}
+	for i := 0; i < len(net.to); i += 2 {
+		res.Flow[net.edge[i]] = net.residual[i^1]
+	}
+	return res, nil
+}
+
+/*
+ * Assignment problem via Hungarian algorithm. Nodes from left are workers,
+ * all other nodes are jobs, and Edge.Weight of edge between them is the cost
+ * of assignment (edge direction is ignored, edges inside one side are
+ * ignored too). With maximize weights are profits instead of costs.
+ *
+ * Missing edges are forbidden assignments, so they get cost bigger than any
+ * possible assignment: result has maximum possible number of pairs first, and
+ * only then the best total weight. Sides may be of different size.
+ *
+ * Costs and potentials are int64 and stay below 2(V + 1) times the
+ * forbidden cost, so graph with too heavy edges is rejected.
+ */
+
+type AssignmentResult struct {
+	Pairs  map[graph.TKey]graph.TKey // left node to its right node
+	Edges  []graph.TKey
+	Weight graph.TWeight
+}
+
+func HungarianAssignment(gr *graph.Graph, left []graph.TKey, maximize bool) (*AssignmentResult, error) {
+	isLeft := make(map[graph.TKey]bool, len(left))
+	rows := []graph.TKey{}
+	for _, key := range left {
+		if _, err := gr.GetNodeByKey(key); err != nil {
+			return nil, err
+		}
+		if !isLeft[key] {
+			isLeft[key] = true
+			rows = append(rows, key)
+		}
+	}
+	slices.Sort(rows)
+	cols := []graph.TKey{}
+	for _, key := range sortedNodeKeys(gr) {
+		if !isLeft[key] {
+			cols = append(cols, key)
+		}
+	}
+
+	// Best edge for every pair of left and right nodes
+	type pair struct{ row, col graph.TKey }
+	best := make(map[pair]graph.TKey)
+	var maxWeight graph.TWeight
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		p := pair{edge.Source, edge.Destination}
+		if !isLeft[p.row] {
+			p = pair{edge.Destination, edge.Source}
+		}
+		if !isLeft[p.row] || isLeft[p.col] {
+			continue
+		}
+		current, ok := best[p]
+		if !ok || maximize && edge.Weight > gr.Edges[current].Weight || !maximize && edge.Weight < gr.Edges[current].Weight {
+			best[p] = key
+		}
+		maxWeight = max(maxWeight, edge.Weight)
+	}
+
+	cost := func(edge graph.TKey) int64 {
+		if maximize {
+			return int64(maxWeight - gr.Edges[edge].Weight)
+		}
+		return int64(gr.Edges[edge].Weight)
+	}
+	limit := graph.TWeight(math.MaxInt64 / ((len(best) + 1) * 2 * (len(rows) + len(cols) + 1)))
+	if maxWeight > limit {
+		return nil, graph.ThrowInvalidParameter("weight", fmt.Sprintf("edge weight %d is heavier than %d allowed for this graph", maxWeight, limit))
+	}
+	var forbidden int64 = 1
+	for _, edge := range best {
+		forbidden += cost(edge)
+	}
+
+	// Hungarian needs rows <= columns, so transpose if needed
+	transposed := len(rows) > len(cols)
+	if transposed {
+		rows, cols = cols, rows
+	}
+	n, m := len(rows), len(cols)
+	edgeAt := func(i, j int) (graph.TKey, bool) {
+		p := pair{rows[i], cols[j]}
+		if transposed {
+			p = pair{cols[j], rows[i]}
+		}
+		key, ok := best[p]
+		return key, ok
+	}
+
+	a := make([][]int64, n+1)
+	for i := 1; i <= n; i++ {
+		a[i] = make([]int64, m+1)
+		for j := 1; j <= m; j++ {
+			a[i][j] = forbidden
+			if key, ok := edgeAt(i-1, j-1); ok {
+				a[i][j] = cost(key)
+			}
+		}
+	}
+
+	u, v := make([]int64, n+1), make([]int64, m+1)
+	p, way := make([]int, m+1), make([]int, m+1)
+	for i := 1; i <= n; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]int64, m+1)
+		used := make([]bool, m+1)
+		for j := range minv {
+			minv[j] = math.MaxInt64
+		}
+		for {
+			used[j0] = true
+			i0, delta, j1 := p[j0], int64(math.MaxInt64), 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				if cur := a[i0][j] - u[i0] - v[j]; cur < minv[j] {
+					minv[j], way[j] = cur, j0
+				}
+				if minv[j] < delta {
+					delta, j1 = minv[j], j
+				}
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	res := &AssignmentResult{Pairs: make(map[graph.TKey]graph.TKey)}
+	for j := 1; j <= m; j++ {
+		if p[j] == 0 {
+			continue
+		}
+		key, ok := edgeAt(p[j]-1, j-1)
+		if !ok {
+			continue
+		}
+		edge := gr.Edges[key]
+		if transposed {
+			res.Pairs[cols[j-1]] = rows[p[j]-1]
+		} else {
+			res.Pairs[rows[p[j]-1]] = cols[j-1]
+		}
+		res.Edges = append(res.Edges, key)
+		res.Weight += edge.Weight
+	}
+	slices.Sort(res.Edges)
+	return res, nil
+}
@@ -0,0 +1,692 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Planarity. Graph is planar if it can be drawn on the plane without edge
+ * crossings; directions, parallel edges and self-loops do not matter here,
+ * so the underlying simple graph is tested.
+ *
+ * If graph is planar, the result has a combinatorial embedding: clockwise
+ * order of neighbours around every node in some crossing-free drawing.
+ * Otherwise it has a Kuratowski witness: edge keys of a subdivision of K5
+ * or K3,3 (by Kuratowski's theorem, one of them is always there), taking
+ * the smallest key of parallel edges.
+ */
+
+type PlanarityResult struct {
+	IsPlanar   bool
+	Embedding  map[graph.TKey][]graph.TKey
+	Kuratowski []graph.TKey
+	Kind       string // "K5" or "K3,3"
+	Note       string // why there is no witness for non-planar graph
+}
+
+// Underlying simple graph by dense indices, each edge once with its key
+type simpleEdges struct {
+	ig    *indexedGraph
+	ends  [][2]int
+	edges []graph.TKey
+}
+
+func makeSimpleEdges(gr *graph.Graph) *simpleEdges {
+	ig := makeUndirectedIndexedGraph(gr)
+	se := &simpleEdges{ig: ig}
+	seen := make(map[[2]int]bool)
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		u, v := ig.index[edge.Source], ig.index[edge.Destination]
+		if u > v {
+			u, v = v, u
+		}
+		if u == v || seen[[2]int{u, v}] {
+			continue
+		}
+		seen[[2]int{u, v}] = true
+		se.ends = append(se.ends, [2]int{u, v})
+		se.edges = append(se.edges, key)
+	}
+	return se
+}
+
+func IsPlanar(gr *graph.Graph) bool {
+	se := makeSimpleEdges(gr)
+	return makeLRPlanarity(len(se.ig.keys), se.ends, nil).test()
+}
+
+/*
+ * Test and embedding are linear, but witness search is not: it runs about
+ * 2k log E tests for witness of k edges, O(k (V + E) log E) in total. So
+ * the graph is shrunk first (see shrinkForWitness), and the shrunk graph is
+ * limited by nodes count. For bigger non-planar graph the result has no
+ * witness, and Note tells why.
+ */
+
+const KuratowskiNodesLimit = 2000
+
+func Planarity(gr *graph.Graph) (*PlanarityResult, error) {
+	se := makeSimpleEdges(gr)
+	n := len(se.ig.keys)
+	lr := makeLRPlanarity(n, se.ends, nil)
+	if lr.test() {
+		rotation := lr.embed()
+		embedding := make(map[graph.TKey][]graph.TKey, n)
+		for v, neighbours := range rotation {
+			embedding[se.ig.keys[v]] = make([]graph.TKey, len(neighbours))
+			for i, w := range neighbours {
+				embedding[se.ig.keys[v]][i] = se.ig.keys[w]
+			}
+		}
+		return &PlanarityResult{IsPlanar: true, Embedding: embedding}, nil
+	}
+
+	shrunk, shrunkEnds, chains := shrinkForWitness(n, se.ends)
+	if shrunk > KuratowskiNodesLimit {
+		return &PlanarityResult{
+			Note: fmt.Sprintf("witness search skipped: graph is shrunk to %d nodes, but the limit is %d", shrunk, KuratowskiNodesLimit),
+		}, nil
+	}
+	witness := []int{}
+	for _, i := range kuratowskiWitness(shrunk, shrunkEnds) {
+		witness = append(witness, chains[i]...)
+	}
+	slices.Sort(witness)
+
+	result := &PlanarityResult{Kuratowski: make([]graph.TKey, 0, len(witness))}
+	degree := make([]int, n)
+	for _, i := range witness {
+		result.Kuratowski = append(result.Kuratowski, se.edges[i])
+		degree[se.ends[i][0]]++
+		degree[se.ends[i][1]]++
+	}
+	branches := 0
+	for _, d := range degree {
+		if d > 2 {
+			branches++
+		}
+	}
+	result.Kind = "K3,3"
+	if branches == 5 {
+		result.Kind = "K5"
+	}
+	return result, nil
+}
+
+/*
+ * Shrinks graph for witness search without changing its planarity: nodes
+ * of degree at most 1 are removed one by one, and every path through nodes
+ * of degree 2 becomes a single edge. Loops made this way are dropped, and
+ * of parallel paths only the shortest is kept; cycles of degree 2 nodes
+ * only vanish. Subdivision of K5 or K3,3 in the result expands back to one
+ * in the original graph. Returns number of nodes and edges of the result,
+ * and indices of original edges making every its edge.
+ */
+
+func shrinkForWitness(n int, ends [][2]int) (int, [][2]int, [][]int) {
+	adj := make([][]lrHalfEdge, n)
+	degree := make([]int, n)
+	for i, e := range ends {
+		adj[e[0]] = append(adj[e[0]], lrHalfEdge{e[1], i})
+		adj[e[1]] = append(adj[e[1]], lrHalfEdge{e[0], i})
+		degree[e[0]]++
+		degree[e[1]]++
+	}
+
+	removed := make([]bool, len(ends))
+	queue := []int{}
+	for v := range n {
+		if degree[v] == 1 {
+			queue = append(queue, v)
+		}
+	}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, half := range adj[v] {
+			if removed[half.edge] {
+				continue
+			}
+			removed[half.edge] = true
+			degree[v]--
+			if degree[half.to]--; degree[half.to] == 1 {
+				queue = append(queue, half.to)
+			}
+		}
+	}
+
+	index := make([]int, n)
+	kept := 0
+	for v := range n {
+		index[v] = -1
+		if degree[v] > 2 {
+			index[v] = kept
+			kept++
+		}
+	}
+
+	used := slices.Clone(removed)
+	best := make(map[[2]int]int)
+	shrunkEnds, chains := [][2]int{}, [][]int{}
+	for u := range n {
+		if index[u] == -1 {
+			continue
+		}
+		for _, half := range adj[u] {
+			if used[half.edge] {
+				continue
+			}
+			// Walk along the path until the next kept node
+			chain := []int{half.edge}
+			used[half.edge] = true
+			v := half.to
+			for index[v] == -1 {
+				for _, next := range adj[v] {
+					if !used[next.edge] {
+						chain = append(chain, next.edge)
+						used[next.edge] = true
+						v = next.to
+						break
+					}
+				}
+			}
+
+			a, b := index[u], index[v]
+			if a == b {
+				continue
+			}
+			pair := [2]int{min(a, b), max(a, b)}
+			if i, ok := best[pair]; ok {
+				if len(chain) < len(chains[i]) {
+					chains[i] = chain
+				}
+				continue
+			}
+			best[pair] = len(shrunkEnds)
+			shrunkEnds = append(shrunkEnds, pair)
+			chains = append(chains, chain)
+		}
+	}
+	return kept, shrunkEnds, chains
+}
+
+/*
+ * Kuratowski witness is an edge-minimal non-planar subgraph: removing any
+ * of its edges makes it planar, so it is exactly a subdivision of K5 or
+ * K3,3. Edges are dropped in chunks while graph stays non-planar, and a
+ * chunk that cannot be dropped is halved, so about 2k log E tests of
+ * O(V + E) are needed for witness of k edges. Returns indices of edges.
+ */
+
+func kuratowskiWitness(n int, ends [][2]int) []int {
+	alive := make([]bool, len(ends))
+	for i := range alive {
+		alive[i] = true
+	}
+	nonPlanarWithout := func(chunk []int) bool {
+		for _, i := range chunk {
+			alive[i] = false
+		}
+		if !makeLRPlanarity(n, ends, alive).test() {
+			return true
+		}
+		for _, i := range chunk {
+			alive[i] = true
+		}
+		return false
+	}
+
+	var reduce func(chunk []int)
+	reduce = func(chunk []int) {
+		if len(chunk) == 0 || nonPlanarWithout(chunk) || len(chunk) == 1 {
+			return
+		}
+		reduce(chunk[:len(chunk)/2])
+		reduce(chunk[len(chunk)/2:])
+	}
+	all := make([]int, len(ends))
+	for i := range all {
+		all[i] = i
+	}
+	reduce(all)
+
+	witness := []int{}
+	for i, ok := range alive {
+		if ok {
+			witness = append(witness, i)
+		}
+	}
+	return witness
+}
+
+/*
+ * Left-right planarity test by Brandes ("The Left-Right Planarity Test"),
+ * O(V + E). DFS orients edges and computes lowpoints, edges out of every
+ * node are sorted by nesting depth, then the second DFS checks that return
+ * edges can be split between left and right sides, keeping constraints as a
+ * stack of conflict pairs of intervals. The third DFS builds embedding from
+ * the found sides.
+ *
+ * Oriented edges are numbered in order of orientation, -1 means no edge.
+ */
+
+type lrInterval struct {
+	low, high int
+}
+
+func (in lrInterval) empty() bool {
+	return in.low == -1 && in.high == -1
+}
+
+type lrConflictPair struct {
+	left, right lrInterval
+}
+
+func (p *lrConflictPair) swap() {
+	p.left, p.right = p.right, p.left
+}
+
+type lrHalfEdge struct {
+	to, edge int
+}
+
+type lrPlanarity struct {
+	n          int
+	m          int
+	adj        [][]lrHalfEdge
+	height     []int
+	parentEdge []int
+	roots      []int
+
+	// Per oriented edge
+	from, to    []int
+	lowpt       []int
+	lowpt2      []int
+	nesting     []int
+	ref         []int
+	side        []int
+	lowptEdge   []int
+	stackBottom []*lrConflictPair
+
+	oriented []bool  // per undirected edge
+	out      [][]int // oriented edges out of node, sorted by nesting later
+	stack    []*lrConflictPair
+
+	// Embedding: clockwise and counter-clockwise neighbours in rotation
+	cw, ccw           []map[int]int
+	first             []int
+	leftRef, rightRef []int
+}
+
+// Edges with alive[i] false are skipped; nil alive means all edges
+func makeLRPlanarity(n int, ends [][2]int, alive []bool) *lrPlanarity {
+	lr := &lrPlanarity{n: n, adj: make([][]lrHalfEdge, n), height: make([]int, n), parentEdge: make([]int, n)}
+
+	// Witness search builds many of these, so everything is allocated once
+	degree := make([]int, n)
+	for i, e := range ends {
+		if alive == nil || alive[i] {
+			degree[e[0]]++
+			degree[e[1]]++
+			lr.m++
+		}
+	}
+	halves := make([]lrHalfEdge, 0, 2*lr.m)
+	outs := make([]int, 0, 2*lr.m)
+	lr.out = make([][]int, n)
+	for v := range n {
+		lr.adj[v], halves = halves[:0:degree[v]], halves[degree[v]:cap(halves)]
+		lr.out[v], outs = outs[:0:degree[v]], outs[degree[v]:cap(outs)]
+		lr.height[v], lr.parentEdge[v] = -1, -1
+	}
+	m := 0
+	for i, e := range ends {
+		if alive == nil || alive[i] {
+			lr.adj[e[0]] = append(lr.adj[e[0]], lrHalfEdge{e[1], m})
+			lr.adj[e[1]] = append(lr.adj[e[1]], lrHalfEdge{e[0], m})
+			m++
+		}
+	}
+	lr.oriented = make([]bool, lr.m)
+	lr.from, lr.to = make([]int, 0, lr.m), make([]int, 0, lr.m)
+	lr.lowpt, lr.lowpt2, lr.nesting = make([]int, 0, lr.m), make([]int, 0, lr.m), make([]int, 0, lr.m)
+	return lr
+}
+
+func (lr *lrPlanarity) test() bool {
+	if lr.n > 2 && lr.m > 3*lr.n-6 {
+		return false
+	}
+
+	for v := range lr.n {
+		if lr.height[v] == -1 {
+			lr.height[v] = 0
+			lr.roots = append(lr.roots, v)
+			lr.orient(v)
+		}
+	}
+	lr.sortOut()
+
+	m := len(lr.from)
+	lr.ref, lr.side, lr.lowptEdge = make([]int, m), make([]int, m), make([]int, m)
+	lr.stackBottom = make([]*lrConflictPair, m)
+	for e := range m {
+		lr.ref[e], lr.side[e], lr.lowptEdge[e] = -1, 1, -1
+	}
+	for _, v := range lr.roots {
+		if !lr.testing(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal nesting depths keep orientation order
+func (lr *lrPlanarity) sortOut() {
+	for v := range lr.n {
+		slices.SortStableFunc(lr.out[v], func(a, b int) int { return cmp.Compare(lr.nesting[a], lr.nesting[b]) })
+	}
+}
+
+func (lr *lrPlanarity) orient(v int) {
+	e := lr.parentEdge[v]
+	for _, half := range lr.adj[v] {
+		if lr.oriented[half.edge] {
+			continue
+		}
+		lr.oriented[half.edge] = true
+		w := half.to
+
+		vw := len(lr.from)
+		lr.from = append(lr.from, v)
+		lr.to = append(lr.to, w)
+		lr.lowpt = append(lr.lowpt, lr.height[v])
+		lr.lowpt2 = append(lr.lowpt2, lr.height[v])
+		lr.nesting = append(lr.nesting, 0)
+		lr.out[v] = append(lr.out[v], vw)
+
+		if lr.height[w] == -1 { // tree edge
+			lr.parentEdge[w] = vw
+			lr.height[w] = lr.height[v] + 1
+			lr.orient(w)
+		} else { // back edge
+			lr.lowpt[vw] = lr.height[w]
+		}
+
+		lr.nesting[vw] = 2 * lr.lowpt[vw]
+		if lr.lowpt2[vw] < lr.height[v] { // chordal
+			lr.nesting[vw]++
+		}
+
+		if e != -1 {
+			if lr.lowpt[vw] < lr.lowpt[e] {
+				lr.lowpt2[e] = min(lr.lowpt[e], lr.lowpt2[vw])
+				lr.lowpt[e] = lr.lowpt[vw]
+			} else if lr.lowpt[vw] > lr.lowpt[e] {
+				lr.lowpt2[e] = min(lr.lowpt2[e], lr.lowpt[vw])
+			} else {
+				lr.lowpt2[e] = min(lr.lowpt2[e], lr.lowpt2[vw])
+			}
+		}
+	}
+}
+
+func (lr *lrPlanarity) top() *lrConflictPair {
+	if len(lr.stack) == 0 {
+		return nil
+	}
+	return lr.stack[len(lr.stack)-1]
+}
+
+func (lr *lrPlanarity) pop() *lrConflictPair {
+	p := lr.stack[len(lr.stack)-1]
+	lr.stack = lr.stack[:len(lr.stack)-1]
+	return p
+}
+
+func (lr *lrPlanarity) conflicting(in lrInterval, b int) bool {
+	return !in.empty() && lr.lowpt[in.high] > lr.lowpt[b]
+}
+
+func (lr *lrPlanarity) lowest(p *lrConflictPair) int {
+	if p.left.empty() {
+		return lr.lowpt[p.right.low]
+	}
+	if p.right.empty() {
+		return lr.lowpt[p.left.low]
+	}
+	return min(lr.lowpt[p.left.low], lr.lowpt[p.right.low])
+}
+
+func (lr *lrPlanarity) testing(v int) bool {
+	e := lr.parentEdge[v]
+	for i, ei := range lr.out[v] {
+		w := lr.to[ei]
+		lr.stackBottom[ei] = lr.top()
+		if ei == lr.parentEdge[w] { // tree edge
+			if !lr.testing(w) {
+				return false
+			}
+		} else { // back edge
+			lr.lowptEdge[ei] = ei
+			lr.stack = append(lr.stack, &lrConflictPair{left: lrInterval{-1, -1}, right: lrInterval{ei, ei}})
+		}
+
+		// Integrate new return edges
+		if lr.lowpt[ei] < lr.height[v] {
+			if i == 0 {
+				lr.lowptEdge[e] = lr.lowptEdge[ei]
+			} else if !lr.addConstraints(ei, e) {
+				return false
+			}
+		}
+	}
+
+	if e != -1 {
+		lr.removeBackEdges(e)
+	}
+	return true
+}
+
+func (lr *lrPlanarity) addConstraints(ei, e int) bool {
+	p := &lrConflictPair{left: lrInterval{-1, -1}, right: lrInterval{-1, -1}}
+
+	// Merge return edges of ei into p.right
+	for {
+		q := lr.pop()
+		if !q.left.empty() {
+			q.swap()
+		}
+		if !q.left.empty() {
+			return false
+		}
+		if lr.lowpt[q.right.low] > lr.lowpt[e] {
+			if p.right.empty() {
+				p.right = q.right
+			} else {
+				lr.ref[p.right.low] = q.right.high
+			}
+			p.right.low = q.right.low
+		} else {
+			lr.ref[q.right.low] = lr.lowptEdge[e]
+		}
+		if lr.top() == lr.stackBottom[ei] {
+			break
+		}
+	}
+
+	// Merge conflicting return edges of previous siblings into p.left
+	for top := lr.top(); top != nil && (lr.conflicting(top.left, ei) || lr.conflicting(top.right, ei)); top = lr.top() {
+		q := lr.pop()
+		if lr.conflicting(q.right, ei) {
+			q.swap()
+		}
+		if lr.conflicting(q.right, ei) {
+			return false
+		}
+		if p.right.low != -1 {
+			lr.ref[p.right.low] = q.right.high
+		}
+		if q.right.low != -1 {
+			p.right.low = q.right.low
+		}
+		if p.left.empty() {
+			p.left = q.left
+		} else {
+			lr.ref[p.left.low] = q.left.high
+		}
+		p.left.low = q.left.low
+	}
+
+	if !p.left.empty() || !p.right.empty() {
+		lr.stack = append(lr.stack, p)
+	}
+	return true
+}
+
+func (lr *lrPlanarity) removeBackEdges(e int) {
+	u := lr.from[e]
+
+	// Drop whole conflict pairs returning to u
+	for len(lr.stack) > 0 && lr.lowest(lr.top()) == lr.height[u] {
+		p := lr.pop()
+		if p.left.low != -1 {
+			lr.side[p.left.low] = -1
+		}
+	}
+
+	if len(lr.stack) > 0 {
+		p := lr.pop()
+		// Trim left interval
+		for p.left.high != -1 && lr.to[p.left.high] == u {
+			p.left.high = lr.ref[p.left.high]
+		}
+		if p.left.high == -1 && p.left.low != -1 {
+			lr.ref[p.left.low] = p.right.low
+			lr.side[p.left.low] = -1
+			p.left.low = -1
+		}
+		// Trim right interval
+		for p.right.high != -1 && lr.to[p.right.high] == u {
+			p.right.high = lr.ref[p.right.high]
+		}
+		if p.right.high == -1 && p.right.low != -1 {
+			lr.ref[p.right.low] = p.left.low
+			lr.side[p.right.low] = -1
+			p.right.low = -1
+		}
+		lr.stack = append(lr.stack, p)
+	}
+
+	// Side of e is the side of its highest return edge
+	if lr.lowpt[e] < lr.height[u] && len(lr.stack) > 0 {
+		hl, hr := lr.top().left.high, lr.top().right.high
+		if hl != -1 && (hr == -1 || lr.lowpt[hl] > lr.lowpt[hr]) {
+			lr.ref[e] = hl
+		} else {
+			lr.ref[e] = hr
+		}
+	}
+}
+
+func (lr *lrPlanarity) sign(e int) int {
+	if lr.ref[e] != -1 {
+		lr.side[e] *= lr.sign(lr.ref[e])
+		lr.ref[e] = -1
+	}
+	return lr.side[e]
+}
+
+// Embedding after successful test: clockwise neighbours of every node
+func (lr *lrPlanarity) embed() [][]int {
+	for e := range lr.from {
+		lr.nesting[e] *= lr.sign(e)
+	}
+	lr.sortOut()
+
+	lr.cw, lr.ccw = make([]map[int]int, lr.n), make([]map[int]int, lr.n)
+	lr.first, lr.leftRef, lr.rightRef = make([]int, lr.n), make([]int, lr.n), make([]int, lr.n)
+	for v := range lr.n {
+		lr.cw[v], lr.ccw[v] = make(map[int]int), make(map[int]int)
+		lr.first[v] = -1
+		previous := -1
+		for _, e := range lr.out[v] {
+			lr.addHalfEdgeCW(v, lr.to[e], previous)
+			previous = lr.to[e]
+		}
+	}
+	for _, v := range lr.roots {
+		lr.embedding(v)
+	}
+
+	rotation := make([][]int, lr.n)
+	for v := range lr.n {
+		rotation[v] = []int{}
+		if lr.first[v] == -1 {
+			continue
+		}
+		w := lr.first[v]
+		for {
+			rotation[v] = append(rotation[v], w)
+			if w = lr.cw[v][w]; w == lr.first[v] {
+				break
+			}
+		}
+	}
+	return rotation
+}
+
+func (lr *lrPlanarity) embedding(v int) {
+	for _, ei := range lr.out[v] {
+		w := lr.to[ei]
+		if ei == lr.parentEdge[w] { // tree edge
+			lr.addHalfEdgeFirst(w, v)
+			lr.leftRef[v], lr.rightRef[v] = w, w
+			lr.embedding(w)
+		} else if lr.side[ei] == 1 {
+			lr.addHalfEdgeCW(w, v, lr.rightRef[w])
+		} else {
+			lr.addHalfEdgeCCW(w, v, lr.leftRef[w])
+			lr.leftRef[w] = v
+		}
+	}
+}
+
+// Inserts w into rotation of v right after reference, clockwise
+func (lr *lrPlanarity) addHalfEdgeCW(v, w, reference int) {
+	if reference == -1 {
+		lr.cw[v][w], lr.ccw[v][w] = w, w
+		lr.first[v] = w
+		return
+	}
+	next := lr.cw[v][reference]
+	lr.cw[v][reference] = w
+	lr.cw[v][w] = next
+	lr.ccw[v][next] = w
+	lr.ccw[v][w] = reference
+}
+
+// Inserts w into rotation of v right before reference
+func (lr *lrPlanarity) addHalfEdgeCCW(v, w, reference int) {
+	if reference == -1 {
+		lr.addHalfEdgeCW(v, w, -1)
+		return
+	}
+	lr.addHalfEdgeCW(v, w, lr.ccw[v][reference])
+	if reference == lr.first[v] {
+		lr.first[v] = w
+	}
+}
+
+func (lr *lrPlanarity) addHalfEdgeFirst(v, w int) {
+	lr.addHalfEdgeCCW(v, w, lr.first[v])
+}
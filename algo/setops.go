@@ -0,0 +1,542 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Set operations on graphs. Both graphs must be directed or both undirected,
+ * the result is a multigraph if any of them is. Operands are never changed.
+ *
+ * Nodes with the same key are the same node, and edges are identified by
+ * their ends (in any order, for undirected graphs). Parallel edges are
+ * counted as a multiset: union keeps max of multiplicities, intersection
+ * keeps min, difference subtracts.
+ *
+ * Key collisions are resolved in favour of the first graph: it keeps all
+ * its node and edge keys, labels and weights. Edges of the second graph keep
+ * their key if it is free, otherwise get the next key after the biggest one
+ * used. Returned KeyMapping tells where keys of the second graph went.
+ */
+
+/*
+ * Complement, join and products may be much bigger than their operands, so
+ * their size is counted before building and limited by ResultSizeLimit,
+ * both for nodes and for edges.
+ */
+
+const ResultSizeLimit = 1000000
+
+// Counts are float64, so that product of big counts does not overflow
+func checkResultSize(nodes, edges float64) error {
+	if nodes > ResultSizeLimit {
+		return graph.ThrowResultTooBig("nodes", int(min(nodes, math.MaxInt32)), ResultSizeLimit)
+	}
+	if edges > ResultSizeLimit {
+		return graph.ThrowResultTooBig("edges", int(min(edges, math.MaxInt32)), ResultSizeLimit)
+	}
+	return nil
+}
+
+type KeyMapping struct {
+	Nodes map[graph.TKey]graph.TKey
+	Edges map[graph.TKey]graph.TKey
+}
+
+func makeKeyMapping() *KeyMapping {
+	return &KeyMapping{Nodes: make(map[graph.TKey]graph.TKey), Edges: make(map[graph.TKey]graph.TKey)}
+}
+
+func cloneNode(node *graph.Node, key graph.TKey) *graph.Node {
+	clone := graph.MakeNode(key, graph.WithNodeLabel(node.Label))
+	for name, value := range node.Attributes {
+		clone.UpdateNode(graph.WithNodeAttribute(name, value))
+	}
+	return clone
+}
+
+func cloneEdge(edge *graph.Edge, key, src, dst graph.TKey) *graph.Edge {
+	return graph.MakeEdge(key, src, dst,
+		graph.WithEdgeWeight(edge.Weight), graph.WithEdgeCost(edge.Cost), graph.WithEdgeLabel(edge.Label))
+}
+
+// Ends of edge, ordered for undirected graphs
+func edgeEnds(gr *graph.Graph, edge *graph.Edge) [2]graph.TKey {
+	if !gr.Options.IsDirected && edge.Source > edge.Destination {
+		return [2]graph.TKey{edge.Destination, edge.Source}
+	}
+	return [2]graph.TKey{edge.Source, edge.Destination}
+}
+
+// Edge keys grouped by ends, each group sorted
+func edgesByEnds(gr *graph.Graph) map[[2]graph.TKey][]graph.TKey {
+	groups := make(map[[2]graph.TKey][]graph.TKey)
+	for _, key := range sortedEdgeKeys(gr) {
+		ends := edgeEnds(gr, gr.Edges[key])
+		groups[ends] = append(groups[ends], key)
+	}
+	return groups
+}
+
+// Gives out keys: wanted one if it is free, or the next after the biggest
+type keyAllocator struct {
+	used map[graph.TKey]bool
+	next graph.TKey
+}
+
+func makeKeyAllocator[V any](taken map[graph.TKey]V) *keyAllocator {
+	ka := &keyAllocator{used: make(map[graph.TKey]bool, len(taken)), next: 1}
+	for key := range taken {
+		ka.used[key] = true
+		ka.next = max(ka.next, key+1)
+	}
+	return ka
+}
+
+func (ka *keyAllocator) take(wanted graph.TKey) graph.TKey {
+	if wanted == 0 || ka.used[wanted] {
+		for ka.used[ka.next] {
+			ka.next++
+		}
+		wanted = ka.next
+	}
+	ka.used[wanted] = true
+	ka.next = max(ka.next, wanted+1)
+	return wanted
+}
+
+func makeOperationGraph(a, b *graph.Graph) (*graph.Graph, error) {
+	if a.Options.IsDirected != b.Options.IsDirected {
+		return nil, graph.ThrowDirectednessMismatch()
+	}
+	return graph.MakeGraph(
+		graph.WithGraphDirected(a.Options.IsDirected),
+		graph.WithGraphMulti(a.Options.IsMulti || b.Options.IsMulti),
+	), nil
+}
+
+/*
+ * Union: all nodes and edges of both graphs. Edges present in both are
+ * taken once (from a).
+ */
+
+func Union(a, b *graph.Graph) (*graph.Graph, *KeyMapping, error) {
+	result, err := makeOperationGraph(a, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	mapping := makeKeyMapping()
+
+	for key, node := range a.Nodes {
+		result.Nodes[key] = cloneNode(node, key)
+	}
+	for key, node := range b.Nodes {
+		if _, ok := result.Nodes[key]; !ok {
+			result.Nodes[key] = cloneNode(node, key)
+		}
+		mapping.Nodes[key] = key
+	}
+
+	for key, edge := range a.Edges {
+		result.Edges[key] = cloneEdge(edge, key, edge.Source, edge.Destination)
+	}
+	common := edgesByEnds(a)
+	keys := makeKeyAllocator(a.Edges)
+	for _, key := range sortedEdgeKeys(b) {
+		edge := b.Edges[key]
+		ends := edgeEnds(b, edge)
+		if len(common[ends]) > 0 {
+			mapping.Edges[key] = common[ends][0]
+			common[ends] = common[ends][1:]
+			continue
+		}
+		newKey := keys.take(key)
+		result.Edges[newKey] = cloneEdge(edge, newKey, edge.Source, edge.Destination)
+		mapping.Edges[key] = newKey
+	}
+
+	result.RebuildAdjacencyMap()
+	return result, mapping, nil
+}
+
+/*
+ * Intersection: nodes present in both graphs and edges present in both.
+ * Mapping tells which edge of a every kept edge of b became.
+ */
+
+func Intersection(a, b *graph.Graph) (*graph.Graph, *KeyMapping, error) {
+	result, err := makeOperationGraph(a, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	mapping := makeKeyMapping()
+
+	for key, node := range a.Nodes {
+		if _, ok := b.Nodes[key]; ok {
+			result.Nodes[key] = cloneNode(node, key)
+			mapping.Nodes[key] = key
+		}
+	}
+
+	other := edgesByEnds(b)
+	for _, key := range sortedEdgeKeys(a) {
+		edge := a.Edges[key]
+		ends := edgeEnds(a, edge)
+		if len(other[ends]) == 0 {
+			continue
+		}
+		result.Edges[key] = cloneEdge(edge, key, edge.Source, edge.Destination)
+		mapping.Edges[other[ends][0]] = key
+		other[ends] = other[ends][1:]
+	}
+
+	result.RebuildAdjacencyMap()
+	return result, mapping, nil
+}
+
+/*
+ * Difference: all nodes of a and edges of a not present in b. Nothing of b
+ * gets to the result, so mapping is empty.
+ */
+
+func Difference(a, b *graph.Graph) (*graph.Graph, *KeyMapping, error) {
+	result, err := makeOperationGraph(a, b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for key, node := range a.Nodes {
+		result.Nodes[key] = cloneNode(node, key)
+	}
+	other := edgesByEnds(b)
+	for _, key := range sortedEdgeKeys(a) {
+		edge := a.Edges[key]
+		ends := edgeEnds(a, edge)
+		if len(other[ends]) > 0 {
+			other[ends] = other[ends][1:]
+			continue
+		}
+		result.Edges[key] = cloneEdge(edge, key, edge.Source, edge.Destination)
+	}
+
+	result.RebuildAdjacencyMap()
+	return result, makeKeyMapping(), nil
+}
+
+/*
+ * Symmetric difference: all nodes of both graphs and edges present in
+ * exactly one of them
+ */
+
+func SymmetricDifference(a, b *graph.Graph) (*graph.Graph, *KeyMapping, error) {
+	result, err := makeOperationGraph(a, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	mapping := makeKeyMapping()
+
+	for key, node := range a.Nodes {
+		result.Nodes[key] = cloneNode(node, key)
+	}
+	for key, node := range b.Nodes {
+		if _, ok := result.Nodes[key]; !ok {
+			result.Nodes[key] = cloneNode(node, key)
+		}
+		mapping.Nodes[key] = key
+	}
+
+	groupsA, groupsB := edgesByEnds(a), edgesByEnds(b)
+	for ends, keysA := range groupsA {
+		for _, key := range keysA[min(len(keysA), len(groupsB[ends])):] {
+			edge := a.Edges[key]
+			result.Edges[key] = cloneEdge(edge, key, edge.Source, edge.Destination)
+		}
+	}
+	keys := makeKeyAllocator(a.Edges)
+	for _, key := range sortedEdgeKeys(b) {
+		edge := b.Edges[key]
+		ends := edgeEnds(b, edge)
+		if len(groupsA[ends]) > 0 {
+			groupsA[ends] = groupsA[ends][1:]
+			continue
+		}
+		newKey := keys.take(key)
+		result.Edges[newKey] = cloneEdge(edge, newKey, edge.Source, edge.Destination)
+		mapping.Edges[key] = newKey
+	}
+
+	result.RebuildAdjacencyMap()
+	return result, mapping, nil
+}
+
+/*
+ * Disjoint union: both graphs side by side, even if their keys collide.
+ * Graph a keeps its keys, keys of b are shifted by the biggest node (edge)
+ * key of a.
+ */
+
+func DisjointUnion(a, b *graph.Graph) (*graph.Graph, *KeyMapping, error) {
+	result, err := makeOperationGraph(a, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	mapping := makeKeyMapping()
+
+	var nodeShift, edgeShift graph.TKey
+	for key, node := range a.Nodes {
+		result.Nodes[key] = cloneNode(node, key)
+		nodeShift = max(nodeShift, key)
+	}
+	for key, edge := range a.Edges {
+		result.Edges[key] = cloneEdge(edge, key, edge.Source, edge.Destination)
+		edgeShift = max(edgeShift, key)
+	}
+
+	for key, node := range b.Nodes {
+		mapping.Nodes[key] = key + nodeShift
+		result.Nodes[key+nodeShift] = cloneNode(node, key+nodeShift)
+	}
+	for key, edge := range b.Edges {
+		mapping.Edges[key] = key + edgeShift
+		result.Edges[key+edgeShift] = cloneEdge(edge, key+edgeShift, edge.Source+nodeShift, edge.Destination+nodeShift)
+	}
+
+	result.RebuildAdjacencyMap()
+	return result, mapping, nil
+}
+
+/*
+ * Join: disjoint union plus edges from every node of a to every node of b
+ * (and back, for directed graphs). New edges have zero weight and keys after
+ * all others.
+ */
+
+func Join(a, b *graph.Graph) (*graph.Graph, *KeyMapping, error) {
+	between := float64(len(a.Nodes)) * float64(len(b.Nodes))
+	if a.Options.IsDirected {
+		between *= 2
+	}
+	if err := checkResultSize(float64(len(a.Nodes)+len(b.Nodes)), float64(len(a.Edges)+len(b.Edges))+between); err != nil {
+		return nil, nil, err
+	}
+	result, mapping, err := DisjointUnion(a, b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := makeKeyAllocator(result.Edges)
+	for _, u := range sortedNodeKeys(a) {
+		for _, v := range sortedNodeKeys(b) {
+			key := keys.take(0)
+			result.Edges[key] = graph.MakeEdge(key, u, mapping.Nodes[v])
+			if result.Options.IsDirected {
+				key = keys.take(0)
+				result.Edges[key] = graph.MakeEdge(key, mapping.Nodes[v], u)
+			}
+		}
+	}
+
+	result.RebuildAdjacencyMap()
+	return result, mapping, nil
+}
+
+/*
+ * Complement: the same nodes, and an edge between two different nodes iff
+ * there was none. The result is a simple graph with edge keys from 1.
+ */
+
+func Complement(gr *graph.Graph) (*graph.Graph, error) {
+	result := graph.MakeGraph(graph.WithGraphDirected(gr.Options.IsDirected))
+	adjacent := make(map[[2]graph.TKey]bool, len(gr.Edges))
+	loops := 0
+	for _, edge := range gr.Edges {
+		ends := edgeEnds(gr, edge)
+		if !adjacent[ends] && edge.Source == edge.Destination {
+			loops++
+		}
+		adjacent[ends] = true
+	}
+
+	n := float64(len(gr.Nodes))
+	pairs := n * (n - 1)
+	if !gr.Options.IsDirected {
+		pairs /= 2
+	}
+	if err := checkResultSize(n, pairs-float64(len(adjacent)-loops)); err != nil {
+		return nil, err
+	}
+
+	nodes := sortedNodeKeys(gr)
+	for _, key := range nodes {
+		result.Nodes[key] = cloneNode(gr.Nodes[key], key)
+	}
+	edgeKey := graph.TKey(1)
+	for i, u := range nodes {
+		for j, v := range nodes {
+			if i == j || !gr.Options.IsDirected && j < i || adjacent[[2]graph.TKey{u, v}] {
+				continue
+			}
+			result.Edges[edgeKey] = graph.MakeEdge(edgeKey, u, v)
+			edgeKey++
+		}
+	}
+
+	result.RebuildAdjacencyMap()
+	return result, nil
+}
+
+/*
+ * Graph products. Nodes of product are pairs (u, v) of nodes of a and b; the
+ * pair of i-th node of a and j-th node of b (by sorted keys) gets key
+ * i * |b| + j + 1 and label "(u, v)", using labels of nodes when they have
+ * ones. Returned map tells the pair for every key. Edge keys go from 1.
+ *
+ * Edge that comes from one factor copies its weight and label, edge that
+ * comes from both gets the sum of weights and labels joined with "×".
+ */
+
+type productKind int
+
+const (
+	productCartesian productKind = iota
+	productTensor
+	productStrong
+	productLexicographic
+)
+
+func CartesianProduct(a, b *graph.Graph) (*graph.Graph, map[graph.TKey][2]graph.TKey, error) {
+	return product(a, b, productCartesian)
+}
+
+func TensorProduct(a, b *graph.Graph) (*graph.Graph, map[graph.TKey][2]graph.TKey, error) {
+	return product(a, b, productTensor)
+}
+
+func StrongProduct(a, b *graph.Graph) (*graph.Graph, map[graph.TKey][2]graph.TKey, error) {
+	return product(a, b, productStrong)
+}
+
+func LexicographicProduct(a, b *graph.Graph) (*graph.Graph, map[graph.TKey][2]graph.TKey, error) {
+	return product(a, b, productLexicographic)
+}
+
+func nodeName(node *graph.Node) string {
+	if node.Label != "" {
+		return node.Label
+	}
+	return fmt.Sprint(node.Key)
+}
+
+// Number of nodes and edges of product, edges of undirected tensor part are
+// counted twice, which is their upper bound
+func productSize(a, b *graph.Graph, kind productKind) (float64, float64) {
+	nodesA, nodesB := float64(len(a.Nodes)), float64(len(b.Nodes))
+	edgesA, edgesB := float64(len(a.Edges)), float64(len(b.Edges))
+	edges := 0.0
+	if kind == productCartesian || kind == productStrong {
+		edges += edgesA * nodesB
+	}
+	if kind != productTensor {
+		edges += nodesA * edgesB
+	}
+	if kind == productTensor || kind == productStrong {
+		edges += 2 * edgesA * edgesB
+	}
+	if kind == productLexicographic {
+		edges += edgesA * nodesB * nodesB
+	}
+	return nodesA * nodesB, edges
+}
+
+func product(a, b *graph.Graph, kind productKind) (*graph.Graph, map[graph.TKey][2]graph.TKey, error) {
+	result, err := makeOperationGraph(a, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkResultSize(productSize(a, b, kind)); err != nil {
+		return nil, nil, err
+	}
+
+	keysA, keysB := sortedNodeKeys(a), sortedNodeKeys(b)
+	pairs := make(map[graph.TKey][2]graph.TKey, len(keysA)*len(keysB))
+	pairKey := make(map[[2]graph.TKey]graph.TKey, len(keysA)*len(keysB))
+	for i, u := range keysA {
+		for j, v := range keysB {
+			key := graph.TKey(i*len(keysB) + j + 1)
+			pairs[key] = [2]graph.TKey{u, v}
+			pairKey[[2]graph.TKey{u, v}] = key
+			result.Nodes[key] = graph.MakeNode(key,
+				graph.WithNodeLabel(fmt.Sprintf("(%s, %s)", nodeName(a.Nodes[u]), nodeName(b.Nodes[v]))))
+		}
+	}
+
+	edgeKey := graph.TKey(1)
+	add := func(src, dst [2]graph.TKey, weight graph.TWeight, label string) {
+		result.Edges[edgeKey] = graph.MakeEdge(edgeKey, pairKey[src], pairKey[dst],
+			graph.WithEdgeWeight(weight), graph.WithEdgeLabel(label))
+		edgeKey++
+	}
+
+	// Edges of a with fixed node of b, and edges of b with fixed node of a
+	if kind == productCartesian || kind == productStrong {
+		for _, key := range sortedEdgeKeys(a) {
+			edge := a.Edges[key]
+			for _, v := range keysB {
+				add([2]graph.TKey{edge.Source, v}, [2]graph.TKey{edge.Destination, v}, edge.Weight, edge.Label)
+			}
+		}
+	}
+	if kind != productTensor {
+		for _, u := range keysA {
+			for _, key := range sortedEdgeKeys(b) {
+				edge := b.Edges[key]
+				add([2]graph.TKey{u, edge.Source}, [2]graph.TKey{u, edge.Destination}, edge.Weight, edge.Label)
+			}
+		}
+	}
+
+	// Edges of both factors at once
+	if kind == productTensor || kind == productStrong {
+		for _, keyA := range sortedEdgeKeys(a) {
+			ea := a.Edges[keyA]
+			for _, keyB := range sortedEdgeKeys(b) {
+				eb := b.Edges[keyB]
+				label := ""
+				if ea.Label != "" || eb.Label != "" {
+					label = ea.Label + "×" + eb.Label
+				}
+				add([2]graph.TKey{ea.Source, eb.Source}, [2]graph.TKey{ea.Destination, eb.Destination}, ea.Weight+eb.Weight, label)
+				// Undirected edges may be crossed the other way too, unless it
+				// gives the same edge (one of them is a loop)
+				if !a.Options.IsDirected && ea.Source != ea.Destination && eb.Source != eb.Destination {
+					add([2]graph.TKey{ea.Source, eb.Destination}, [2]graph.TKey{ea.Destination, eb.Source}, ea.Weight+eb.Weight, label)
+				}
+			}
+		}
+	}
+
+	// Edge of a connects every node of one copy of b with every node of other
+	if kind == productLexicographic {
+		for _, key := range sortedEdgeKeys(a) {
+			edge := a.Edges[key]
+			for _, v := range keysB {
+				for _, w := range keysB {
+					if edge.Source == edge.Destination && !a.Options.IsDirected && w < v {
+						continue
+					}
+					add([2]graph.TKey{edge.Source, v}, [2]graph.TKey{edge.Destination, w}, edge.Weight, edge.Label)
+				}
+			}
+		}
+	}
+
+	result.RebuildAdjacencyMap()
+	return result, pairs, nil
+}
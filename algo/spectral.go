@@ -0,0 +1,323 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"cmp"
+	"math"
+	"math/big"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Spectral graph theory. All matrices are built for the underlying
+ * undirected graph, so they are symmetric and have real eigenvalues. Rows
+ * and columns go in order of sorted node keys, which are returned along
+ * with matrix. Parallel edges add up; if weighted, edge contributes its
+ * weight instead of 1.
+ */
+
+// Limit for eigenvalues and spanning tree count, both are O(V^3), the
+// latter with big numbers
+const SpectralNodesLimit = 100
+
+/*
+ * Adjacency matrix: A[u][v] is the number (or total weight) of edges
+ * between u and v. Self-loop is counted once on the diagonal.
+ */
+
+func AdjacencyMatrix(gr *graph.Graph, weighted bool) ([]graph.TKey, [][]float64) {
+	keys := sortedNodeKeys(gr)
+	index := make(map[graph.TKey]int, len(keys))
+	for i, key := range keys {
+		index[key] = i
+	}
+
+	matrix := makeMatrix(len(keys))
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		value := 1.0
+		if weighted {
+			value = float64(edge.Weight)
+		}
+		u, v := index[edge.Source], index[edge.Destination]
+		matrix[u][v] += value
+		if u != v {
+			matrix[v][u] += value
+		}
+	}
+	return keys, matrix
+}
+
+func makeMatrix(n int) [][]float64 {
+	matrix := make([][]float64, n)
+	for i := range matrix {
+		matrix[i] = make([]float64, n)
+	}
+	return matrix
+}
+
+// Degree matrix: row sums of adjacency matrix on the diagonal
+func DegreeMatrix(gr *graph.Graph, weighted bool) ([]graph.TKey, [][]float64) {
+	keys, adjacency := AdjacencyMatrix(gr, weighted)
+	matrix := makeMatrix(len(keys))
+	for i, row := range adjacency {
+		for _, value := range row {
+			matrix[i][i] += value
+		}
+	}
+	return keys, matrix
+}
+
+/*
+ * Laplacian L = D - A. Rows sum to zero, so self-loops do not affect it.
+ * Its eigenvalues are non-negative, and the number of zero ones is the
+ * number of connected components.
+ */
+
+func LaplacianMatrix(gr *graph.Graph, weighted bool) ([]graph.TKey, [][]float64) {
+	keys, matrix := AdjacencyMatrix(gr, weighted)
+	for i, row := range matrix {
+		degree := 0.0
+		for j, value := range row {
+			degree += value
+			row[j] = -value
+		}
+		row[i] += degree
+	}
+	return keys, matrix
+}
+
+/*
+ * Normalized Laplacian D^-1/2 L D^-1/2. Its eigenvalues are from 0 to 2.
+ * Rows and columns of isolated nodes are zero.
+ */
+
+func NormalizedLaplacianMatrix(gr *graph.Graph, weighted bool) ([]graph.TKey, [][]float64) {
+	keys, matrix := LaplacianMatrix(gr, weighted)
+	scale := make([]float64, len(keys))
+	for i := range matrix {
+		if matrix[i][i] > 0 {
+			scale[i] = 1 / math.Sqrt(matrix[i][i])
+		}
+	}
+	for i, row := range matrix {
+		for j := range row {
+			row[j] *= scale[i] * scale[j]
+		}
+	}
+	return keys, matrix
+}
+
+/*
+ * Eigenvalues and eigenvectors of symmetric matrix by cyclic Jacobi method:
+ * every sweep rotates each off-diagonal element to zero, until the matrix
+ * is diagonal up to rounding. Values are sorted ascending, vectors[i] is a
+ * unit vector for values[i], with its first non-zero component positive.
+ * Matrix is not changed. About ten sweeps of O(n^3) are usually enough.
+ */
+
+const (
+	JacobiMaxSweeps = 100
+	JacobiTolerance = 1e-12
+)
+
+func JacobiEigen(matrix [][]float64) ([]float64, [][]float64) {
+	n := len(matrix)
+	a := makeMatrix(n)
+	v := makeMatrix(n)
+	scale := 0.0
+	for i := range n {
+		copy(a[i], matrix[i])
+		v[i][i] = 1
+		for j := range n {
+			scale += a[i][j] * a[i][j]
+		}
+	}
+
+	for range JacobiMaxSweeps {
+		off := 0.0
+		for p := range n {
+			for q := p + 1; q < n; q++ {
+				off += a[p][q] * a[p][q]
+			}
+		}
+		if off <= JacobiTolerance*JacobiTolerance*scale {
+			break
+		}
+
+		for p := range n {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+				// Rotation by angle with tangent t, the smaller root
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+
+				for k := range n {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p], a[k][q] = c*akp-s*akq, s*akp+c*akq
+				}
+				for k := range n {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k], a[q][k] = c*apk-s*aqk, s*apk+c*aqk
+				}
+				for k := range n {
+					vkp, vkq := v[k][p], v[k][q]
+					v[k][p], v[k][q] = c*vkp-s*vkq, s*vkp+c*vkq
+				}
+			}
+		}
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int { return cmp.Compare(a[i][i], a[j][j]) })
+
+	values := make([]float64, n)
+	vectors := make([][]float64, n)
+	for i, column := range order {
+		values[i] = a[column][column]
+		vectors[i] = make([]float64, n)
+		for k := range n {
+			vectors[i][k] = v[k][column]
+		}
+		for _, x := range vectors[i] {
+			if math.Abs(x) > JacobiTolerance {
+				if x < 0 {
+					for k := range vectors[i] {
+						vectors[i][k] = -vectors[i][k]
+					}
+				}
+				break
+			}
+		}
+	}
+	return values, vectors
+}
+
+func checkSpectralLimit(gr *graph.Graph) error {
+	if len(gr.Nodes) > SpectralNodesLimit {
+		return graph.ThrowTooManyNodes(len(gr.Nodes), SpectralNodesLimit)
+	}
+	return nil
+}
+
+// Eigenvalues of adjacency matrix, ascending
+func AdjacencySpectrum(gr *graph.Graph, weighted bool) ([]float64, error) {
+	if err := checkSpectralLimit(gr); err != nil {
+		return nil, err
+	}
+	_, matrix := AdjacencyMatrix(gr, weighted)
+	values, _ := JacobiEigen(matrix)
+	return values, nil
+}
+
+// Eigenvalues of Laplacian, ascending
+func LaplacianSpectrum(gr *graph.Graph, weighted bool) ([]float64, error) {
+	if err := checkSpectralLimit(gr); err != nil {
+		return nil, err
+	}
+	_, matrix := LaplacianMatrix(gr, weighted)
+	values, _ := JacobiEigen(matrix)
+	return values, nil
+}
+
+/*
+ * Algebraic connectivity is the second smallest Laplacian eigenvalue: it
+ * is positive iff graph is connected, and the bigger it is, the harder it
+ * is to cut graph into pieces. Its eigenvector (Fiedler vector) puts nodes
+ * on a line; splitting by sign gives a good cut. Graph with less than two
+ * nodes has connectivity 0 and empty vector.
+ */
+
+func AlgebraicConnectivity(gr *graph.Graph, weighted bool) (float64, map[graph.TKey]float64, error) {
+	if err := checkSpectralLimit(gr); err != nil {
+		return 0, nil, err
+	}
+	keys, matrix := LaplacianMatrix(gr, weighted)
+	if len(keys) < 2 {
+		return 0, map[graph.TKey]float64{}, nil
+	}
+
+	values, vectors := JacobiEigen(matrix)
+	fiedler := make(map[graph.TKey]float64, len(keys))
+	for i, key := range keys {
+		fiedler[key] = vectors[1][i]
+	}
+	return values[1], fiedler, nil
+}
+
+/*
+ * Number of spanning trees by Kirchhoff's matrix-tree theorem: determinant
+ * of Laplacian without one row and column. Parallel edges count as
+ * different, so they multiply the number of trees; self-loops are ignored.
+ * Determinant is computed exactly by Bareiss algorithm, where every
+ * division is exact, so the answer is a big integer. Disconnected graph
+ * has 0 spanning trees, empty graph too.
+ */
+
+func SpanningTreeCount(gr *graph.Graph) (*big.Int, error) {
+	if err := checkSpectralLimit(gr); err != nil {
+		return nil, err
+	}
+	_, laplacian := LaplacianMatrix(gr, false)
+	n := len(laplacian) - 1
+	if n < 0 {
+		return big.NewInt(0), nil
+	}
+
+	m := make([][]*big.Int, n)
+	for i := range n {
+		m[i] = make([]*big.Int, n)
+		for j := range n {
+			m[i][j] = big.NewInt(int64(laplacian[i+1][j+1]))
+		}
+	}
+
+	sign := 1
+	previous := big.NewInt(1)
+	for k := range n {
+		if m[k][k].Sign() == 0 {
+			pivot := k + 1
+			for pivot < n && m[pivot][k].Sign() == 0 {
+				pivot++
+			}
+			if pivot == n {
+				return big.NewInt(0), nil
+			}
+			m[k], m[pivot] = m[pivot], m[k]
+			sign = -sign
+		}
+		for i := k + 1; i < n; i++ {
+			for j := k + 1; j < n; j++ {
+				// m[i][j] = (m[i][j] * m[k][k] - m[i][k] * m[k][j]) / previous
+				value := new(big.Int).Mul(m[i][j], m[k][k])
+				value.Sub(value, new(big.Int).Mul(m[i][k], m[k][j]))
+				m[i][j] = value.Quo(value, previous)
+			}
+		}
+		previous = m[k][k]
+	}
+
+	if n == 0 {
+		return big.NewInt(1), nil
+	}
+	result := new(big.Int).Set(m[n-1][n-1])
+	if sign < 0 {
+		result.Neg(result)
+	}
+	return result, nil
+}
@@ -0,0 +1,386 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Tree algorithms. Edge directions are ignored: directed graph is a tree if
+ * its underlying undirected graph is. Tree must have at least one node, be
+ * connected and have exactly n - 1 edges, so self-loops and parallel edges
+ * are not allowed. Forest is a graph whose every component is a tree; empty
+ * graph is a forest, but not a tree.
+ */
+
+func IsForest(gr *graph.Graph) bool {
+	components := len(GroupComponents(WeaklyConnectedComponents(gr)))
+	return len(gr.Edges) == len(gr.Nodes)-components
+}
+
+func IsTree(gr *graph.Graph) bool {
+	return len(gr.Nodes) > 0 && len(gr.Edges) == len(gr.Nodes)-1 && IsConnected(gr)
+}
+
+func treeIndexedGraph(gr *graph.Graph) (*indexedGraph, error) {
+	if !IsTree(gr) {
+		return nil, graph.ThrowGraphNotTree()
+	}
+	return makeUndirectedIndexedGraph(gr), nil
+}
+
+/*
+ * Prüfer code of tree with n >= 2 nodes: n - 2 keys, got by removing the
+ * leaf with the smallest key and writing down its neighbour, until two nodes
+ * are left. Linear: pointer to the smallest leaf only moves forward, unless
+ * the removal makes a smaller leaf, which is then removed right away.
+ *
+ * Code works for any keys, but PruferDecode builds tree on keys 1..n, so it
+ * gives the same tree back only if keys were 1..n.
+ */
+
+func PruferEncode(gr *graph.Graph) ([]graph.TKey, error) {
+	ig, err := treeIndexedGraph(gr)
+	if err != nil {
+		return nil, err
+	}
+	n := len(ig.keys)
+	if n < 2 {
+		return []graph.TKey{}, nil
+	}
+
+	// Rooted at the biggest key, which is never removed, removed leaf's
+	// neighbour is always its parent
+	tree := rootIndexed(ig, n-1)
+	degree := make([]int, n)
+	for v := range n {
+		degree[v] = len(ig.out[v])
+	}
+
+	code := make([]graph.TKey, 0, n-2)
+	leaf := 0
+	for degree[leaf] != 1 {
+		leaf++
+	}
+	next := leaf
+	for range n - 2 {
+		p := tree.parent[next]
+		code = append(code, ig.keys[p])
+		degree[p]--
+		if degree[p] == 1 && p < leaf {
+			next = p
+			continue
+		}
+		leaf++
+		for degree[leaf] != 1 {
+			leaf++
+		}
+		next = leaf
+	}
+	return code, nil
+}
+
+/*
+ * Tree on nodes 1..n for Prüfer code of length n - 2. Every element must be
+ * from 1 to n. Edges go from removed leaf to its neighbour, keys from 1.
+ */
+
+func PruferDecode(code []graph.TKey) (*graph.Graph, error) {
+	n := len(code) + 2
+	degree := make([]int, n+1)
+	for v := 1; v <= n; v++ {
+		degree[v] = 1
+	}
+	for _, v := range code {
+		if v < 1 || v > graph.TKey(n) {
+			return nil, graph.ThrowInvalidParameter("code", "every element must be from 1 to its length + 2")
+		}
+		degree[v]++
+	}
+
+	gr := graph.MakeGraph()
+	for v := graph.TKey(1); v <= graph.TKey(n); v++ {
+		gr.Nodes[v] = graph.MakeNode(v)
+	}
+	edgeKey := graph.TKey(1)
+	addEdge := func(u, v graph.TKey) {
+		gr.Edges[edgeKey] = graph.MakeEdge(edgeKey, u, v)
+		edgeKey++
+	}
+
+	leaf := 1
+	for degree[leaf] != 1 {
+		leaf++
+	}
+	next := leaf
+	for _, v := range code {
+		addEdge(graph.TKey(next), v)
+		degree[v]--
+		if degree[v] == 1 && int(v) < leaf {
+			next = int(v)
+			continue
+		}
+		leaf++
+		for degree[leaf] != 1 {
+			leaf++
+		}
+		next = leaf
+	}
+	// Two nodes are left: the last leaf and n
+	addEdge(graph.TKey(next), graph.TKey(n))
+
+	gr.RebuildAdjacencyMap()
+	return gr, nil
+}
+
+/*
+ * Center of tree: one or two nodes in the middle of every longest path,
+ * found by peeling leaves layer by layer. Counted in edges, not weights.
+ */
+
+func TreeCenter(gr *graph.Graph) ([]graph.TKey, error) {
+	ig, err := treeIndexedGraph(gr)
+	if err != nil {
+		return nil, err
+	}
+	n := len(ig.keys)
+
+	degree := make([]int, n)
+	var layer []int
+	for v := range n {
+		degree[v] = len(ig.out[v])
+		if degree[v] <= 1 {
+			layer = append(layer, v)
+		}
+	}
+	for left := n; left > 2; {
+		left -= len(layer)
+		var next []int
+		for _, u := range layer {
+			for _, a := range ig.out[u] {
+				degree[a.to]--
+				if degree[a.to] == 1 {
+					next = append(next, a.to)
+				}
+			}
+		}
+		layer = next
+	}
+
+	center := make([]graph.TKey, len(layer))
+	for i, v := range layer {
+		center[i] = ig.keys[v]
+	}
+	slices.Sort(center)
+	return center, nil
+}
+
+/*
+ * Diameter of tree: the longest path, as node keys, and its length. Two
+ * traversals: the farthest node from any node is an end of some longest
+ * path, and the farthest node from it is the other end. Length is in edges
+ * or, if weighted, in edge weights.
+ */
+
+func TreeDiameter(gr *graph.Graph, weighted bool) ([]graph.TKey, graph.TWeight, error) {
+	ig, err := treeIndexedGraph(gr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	farthest := func(tree *indexedTree) int {
+		best := tree.root
+		for _, v := range tree.order {
+			if tree.length(v, weighted) > tree.length(best, weighted) {
+				best = v
+			}
+		}
+		return best
+	}
+
+	start := farthest(rootIndexed(ig, 0))
+	tree := rootIndexed(ig, start)
+	end := farthest(tree)
+
+	path := []graph.TKey{ig.keys[end]}
+	for v := end; v != start; v = tree.parent[v] {
+		path = append(path, ig.keys[tree.parent[v]])
+	}
+	return path, tree.length(end, weighted), nil
+}
+
+// Tree rooted by BFS over dense indices
+type indexedTree struct {
+	root     int
+	parent   []int
+	depth    []int
+	distance []graph.TWeight // sum of weights up to root
+	order    []int           // BFS order, root first
+}
+
+func rootIndexed(ig *indexedGraph, root int) *indexedTree {
+	n := len(ig.keys)
+	tree := &indexedTree{
+		root:     root,
+		parent:   make([]int, n),
+		depth:    make([]int, n),
+		distance: make([]graph.TWeight, n),
+		order:    make([]int, 0, n),
+	}
+	tree.parent[root] = -1
+	tree.order = append(tree.order, root)
+	for head := 0; head < len(tree.order); head++ {
+		u := tree.order[head]
+		for _, a := range ig.out[u] {
+			if a.to == tree.parent[u] {
+				continue
+			}
+			tree.parent[a.to] = u
+			tree.depth[a.to] = tree.depth[u] + 1
+			tree.distance[a.to] = tree.distance[u] + a.weight
+			tree.order = append(tree.order, a.to)
+		}
+	}
+	return tree
+}
+
+func (tree *indexedTree) length(v int, weighted bool) graph.TWeight {
+	if weighted {
+		return tree.distance[v]
+	}
+	return graph.TWeight(tree.depth[v])
+}
+
+/*
+ * Tree rooted at given node. Root has no parent; children are sorted by
+ * key; depth is the number of edges to root. Order lists nodes in BFS order
+ * starting with root, so every parent goes before its children.
+ */
+
+type RootedTree struct {
+	Root     graph.TKey
+	Parent   map[graph.TKey]graph.TKey
+	Children map[graph.TKey][]graph.TKey
+	Depth    map[graph.TKey]int
+	Order    []graph.TKey
+}
+
+func RootTree(gr *graph.Graph, root graph.TKey) (*RootedTree, error) {
+	if _, err := gr.GetNodeByKey(root); err != nil {
+		return nil, err
+	}
+	ig, err := treeIndexedGraph(gr)
+	if err != nil {
+		return nil, err
+	}
+	tree := rootIndexed(ig, ig.index[root])
+
+	n := len(ig.keys)
+	result := &RootedTree{
+		Root:     root,
+		Parent:   make(map[graph.TKey]graph.TKey, n-1),
+		Children: make(map[graph.TKey][]graph.TKey, n),
+		Depth:    make(map[graph.TKey]int, n),
+		Order:    make([]graph.TKey, n),
+	}
+	for i, v := range tree.order {
+		key := ig.keys[v]
+		result.Order[i] = key
+		result.Depth[key] = tree.depth[v]
+		result.Children[key] = []graph.TKey{}
+		if v != tree.root {
+			parent := ig.keys[tree.parent[v]]
+			result.Parent[key] = parent
+			result.Children[parent] = append(result.Children[parent], key)
+		}
+	}
+	for _, children := range result.Children {
+		slices.Sort(children)
+	}
+	return result, nil
+}
+
+/*
+ * Lowest common ancestor by binary lifting: up[j][v] is the ancestor of v
+ * 2^j levels higher (root for too high). Building is O(n log n), each query
+ * is O(log n).
+ */
+
+type LCA struct {
+	tree  *RootedTree
+	index map[graph.TKey]int
+	keys  []graph.TKey
+	depth []int
+	up    [][]int
+}
+
+func MakeLCA(tree *RootedTree) *LCA {
+	n := len(tree.Order)
+	lca := &LCA{tree: tree, index: make(map[graph.TKey]int, n), keys: tree.Order, depth: make([]int, n)}
+	for i, key := range tree.Order {
+		lca.index[key] = i
+		lca.depth[i] = tree.Depth[key]
+	}
+
+	levels := 1
+	for 1<<levels < n {
+		levels++
+	}
+	lca.up = make([][]int, levels)
+	lca.up[0] = make([]int, n)
+	for i, key := range tree.Order {
+		if parent, ok := tree.Parent[key]; ok {
+			lca.up[0][i] = lca.index[parent]
+		}
+	}
+	for j := 1; j < levels; j++ {
+		lca.up[j] = make([]int, n)
+		for i := range n {
+			lca.up[j][i] = lca.up[j-1][lca.up[j-1][i]]
+		}
+	}
+	return lca
+}
+
+func (lca *LCA) Query(u, v graph.TKey) (graph.TKey, error) {
+	a, ok := lca.index[u]
+	if !ok {
+		return 0, graph.ThrowNodeWithKeyNotExists(u)
+	}
+	b, ok := lca.index[v]
+	if !ok {
+		return 0, graph.ThrowNodeWithKeyNotExists(v)
+	}
+
+	if lca.depth[a] < lca.depth[b] {
+		a, b = b, a
+	}
+	for j := len(lca.up) - 1; j >= 0; j-- {
+		if lca.depth[a]-(1<<j) >= lca.depth[b] {
+			a = lca.up[j][a]
+		}
+	}
+	if a == b {
+		return lca.keys[a], nil
+	}
+	for j := len(lca.up) - 1; j >= 0; j-- {
+		if lca.up[j][a] != lca.up[j][b] {
+			a, b = lca.up[j][a], lca.up[j][b]
+		}
+	}
+	return lca.keys[lca.up[0][a]], nil
+}
+
+// Number of edges between two nodes of tree, through their LCA
+func (lca *LCA) Distance(u, v graph.TKey) (int, error) {
+	ancestor, err := lca.Query(u, v)
+	if err != nil {
+		return 0, err
+	}
+	return lca.tree.Depth[u] + lca.tree.Depth[v] - 2*lca.tree.Depth[ancestor], nil
+}
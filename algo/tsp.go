@@ -0,0 +1,465 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Hamiltonian paths and travelling salesman problem. These are NP-hard, so
+ * exact algorithms are exponential and guarded by node limits, and all of
+ * the functions take context.Context: cancel it to stop long search, the
+ * error will be ctx.Err().
+ *
+ * Tour is a closed route: Nodes are in visiting order (start is not
+ * repeated at the end), Edges are edge keys actually walked, Cost is the sum
+ * of their weights.
+ */
+
+type Tour struct {
+	Nodes []graph.TKey
+	Edges []graph.TKey
+	Cost  graph.TWeight
+}
+
+const (
+	HeldKarpNodesLimit = 18
+	noEdge             = math.MaxUint64
+	cancelCheckPeriod  = 1 << 10
+)
+
+/*
+ * Direct edge matrix over dense node indices: the lightest edge from i to j
+ * (respecting direction) and its weight, noEdge if there is none
+ */
+
+func edgeMatrix(gr *graph.Graph, keys []graph.TKey) ([][]graph.TWeight, [][]graph.TKey) {
+	index := make(map[graph.TKey]int, len(keys))
+	for i, key := range keys {
+		index[key] = i
+	}
+
+	n := len(keys)
+	weight, edgeKey := make([][]graph.TWeight, n), make([][]graph.TKey, n)
+	for i := range n {
+		weight[i], edgeKey[i] = make([]graph.TWeight, n), make([]graph.TKey, n)
+		for j := range n {
+			weight[i][j] = noEdge
+		}
+	}
+
+	for _, key := range sortedEdgeKeys(gr) {
+		edge := gr.Edges[key]
+		u, v := index[edge.Source], index[edge.Destination]
+		if u == v {
+			continue
+		}
+		if edge.Weight < weight[u][v] {
+			weight[u][v], edgeKey[u][v] = edge.Weight, key
+		}
+		if !gr.Options.IsDirected && edge.Weight < weight[v][u] {
+			weight[v][u], edgeKey[v][u] = edge.Weight, key
+		}
+	}
+	return weight, edgeKey
+}
+
+/*
+ * Exact TSP via Held-Karp dynamic programming: dp[mask][j] is the cheapest
+ * path starting at the first node, visiting nodes of mask and ending at j.
+ * O(2^n * n^2) time and O(2^n * n) memory, so the graph is limited by
+ * HeldKarpNodesLimit nodes. Works for directed graphs too. Returns the
+ * cheapest Hamiltonian cycle or error if there is none.
+ */
+
+func HeldKarpTSP(ctx context.Context, gr *graph.Graph) (*Tour, error) {
+	keys := sortedNodeKeys(gr)
+	n := len(keys)
+	if n > HeldKarpNodesLimit {
+		return nil, graph.ThrowTooManyNodes(n, HeldKarpNodesLimit)
+	}
+	if n <= 1 {
+		return &Tour{Nodes: keys}, nil
+	}
+
+	if n == 2 && !gr.Options.IsDirected {
+		return twoNodeTour(gr, keys)
+	}
+
+	weight, edgeKey := edgeMatrix(gr, keys)
+	full := 1<<n - 1
+	dp := make([]graph.TWeight, (full+1)*n)
+	parent := make([]int8, (full+1)*n)
+	for i := range dp {
+		dp[i] = noEdge
+	}
+	dp[1*n+0] = 0
+
+	for mask := 1; mask <= full; mask += 2 {
+		if mask%cancelCheckPeriod == 1 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		for last := range n {
+			current := dp[mask*n+last]
+			if current == noEdge {
+				continue
+			}
+			for next := range n {
+				if mask&(1<<next) != 0 || weight[last][next] == noEdge {
+					continue
+				}
+				nextMask := mask | 1<<next
+				if cost := current + weight[last][next]; cost < dp[nextMask*n+next] {
+					dp[nextMask*n+next] = cost
+					parent[nextMask*n+next] = int8(last)
+				}
+			}
+		}
+	}
+
+	best, bestLast := graph.TWeight(noEdge), -1
+	for last := 1; last < n; last++ {
+		if dp[full*n+last] == noEdge || weight[last][0] == noEdge {
+			continue
+		}
+		if cost := dp[full*n+last] + weight[last][0]; cost < best {
+			best, bestLast = cost, last
+		}
+	}
+	if bestLast == -1 {
+		return nil, graph.ThrowNoHamiltonianCycle()
+	}
+
+	order := []int{}
+	for mask, last := full, bestLast; last != 0; {
+		order = append(order, last)
+		mask, last = mask&^(1<<last), int(parent[mask*n+last])
+	}
+	order = append(order, 0)
+	slices.Reverse(order)
+
+	tour := &Tour{Cost: best}
+	for i, node := range order {
+		tour.Nodes = append(tour.Nodes, keys[node])
+		tour.Edges = append(tour.Edges, edgeKey[node][order[(i+1)%n]])
+	}
+	return tour, nil
+}
+
+/*
+ * Undirected cycle through two nodes must go there and back by two
+ * different parallel edges, so take the two lightest ones
+ */
+
+func twoNodeTour(gr *graph.Graph, keys []graph.TKey) (*Tour, error) {
+	between := []graph.TKey{}
+	for _, key := range sortedEdgeKeys(gr) {
+		if edge := gr.Edges[key]; edge.Source != edge.Destination {
+			between = append(between, key)
+		}
+	}
+	if len(between) < 2 {
+		return nil, graph.ThrowNoHamiltonianCycle()
+	}
+	slices.SortStableFunc(between, func(a, b graph.TKey) int {
+		return cmp.Compare(gr.Edges[a].Weight, gr.Edges[b].Weight)
+	})
+	return &Tour{
+		Nodes: keys,
+		Edges: between[:2],
+		Cost:  gr.Edges[between[0]].Weight + gr.Edges[between[1]].Weight,
+	}, nil
+}
+
+/*
+ * Hamiltonian path by backtracking: extend path by unvisited neighbours in
+ * key order, step back when stuck. Exponential in the worst case, so use
+ * context to limit the time. Returns path as node keys.
+ */
+
+func HamiltonianPath(ctx context.Context, gr *graph.Graph) ([]graph.TKey, error) {
+	keys := sortedNodeKeys(gr)
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	arcs := outArcs(gr)
+	for key := range arcs {
+		slices.SortStableFunc(arcs[key], func(a, b arc) int {
+			return cmp.Compare(a.To, b.To)
+		})
+	}
+
+	visited := make(map[graph.TKey]bool, len(keys))
+	path := make([]graph.TKey, 0, len(keys))
+	steps := 0
+	var err error
+
+	var extend func(node graph.TKey) bool
+	extend = func(node graph.TKey) bool {
+		steps++
+		if steps%cancelCheckPeriod == 0 {
+			if err = ctx.Err(); err != nil {
+				return false
+			}
+		}
+
+		visited[node] = true
+		path = append(path, node)
+		if len(path) == len(keys) {
+			return true
+		}
+		for _, a := range arcs[node] {
+			if !visited[a.To] && extend(a.To) {
+				return true
+			}
+			if err != nil {
+				return false
+			}
+		}
+		visited[node] = false
+		path = path[:len(path)-1]
+		return false
+	}
+
+	for _, start := range keys {
+		if extend(start) {
+			return path, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nil, graph.ThrowNoHamiltonianPath()
+}
+
+/*
+ * Heuristics for big undirected graphs. They work on metric closure of the
+ * graph: distance between two nodes is the shortest path length, so graph
+ * does not have to be complete. Consecutive nodes of the tour are connected
+ * by shortest paths, and Tour.Edges contains all edges of those paths. For
+ * complete graphs with triangle inequality it is a usual Hamiltonian cycle.
+ */
+
+type metricClosure struct {
+	keys []graph.TKey
+	dist [][]graph.TWeight
+	prev []map[graph.TKey]arc
+}
+
+func makeMetricClosure(ctx context.Context, gr *graph.Graph) (*metricClosure, error) {
+	if gr.Options.IsDirected {
+		return nil, graph.ThrowGraphDirected()
+	}
+	if !IsConnected(gr) {
+		return nil, graph.ThrowGraphNotConnected()
+	}
+
+	closure := &metricClosure{keys: sortedNodeKeys(gr)}
+	arcs := outArcs(gr)
+	n := len(closure.keys)
+	closure.dist = make([][]graph.TWeight, n)
+	closure.prev = make([]map[graph.TKey]arc, n)
+	for i, key := range closure.keys {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		dist, prev := dijkstra(gr, arcs, key)
+		closure.dist[i], closure.prev[i] = make([]graph.TWeight, n), prev
+		for j, other := range closure.keys {
+			closure.dist[i][j] = dist[other]
+		}
+	}
+	return closure, nil
+}
+
+func (closure *metricClosure) tour(order []int) *Tour {
+	tour := &Tour{}
+	n := len(order)
+	for i, node := range order {
+		tour.Nodes = append(tour.Nodes, closure.keys[node])
+		if n == 1 {
+			break
+		}
+
+		// Path from next node back to this one, reversed
+		next := order[(i+1)%n]
+		path := []graph.TKey{}
+		for at := closure.keys[next]; at != closure.keys[node]; at = closure.prev[node][at].To {
+			path = append(path, closure.prev[node][at].Edge)
+		}
+		slices.Reverse(path)
+		tour.Edges = append(tour.Edges, path...)
+		tour.Cost += closure.dist[node][next]
+	}
+	return tour
+}
+
+/*
+ * Nearest neighbour: always go to the closest unvisited node. Then the tour
+ * is improved by 2-opt: reverse a segment while it makes the tour shorter
+ */
+
+func NearestNeighbourTSP(ctx context.Context, gr *graph.Graph) (*Tour, error) {
+	closure, err := makeMetricClosure(ctx, gr)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(closure.keys)
+	if n == 0 {
+		return &Tour{}, nil
+	}
+
+	visited := make([]bool, n)
+	order := []int{0}
+	visited[0] = true
+	for len(order) < n {
+		last, next := order[len(order)-1], -1
+		for j := range n {
+			if !visited[j] && (next == -1 || closure.dist[last][j] < closure.dist[last][next]) {
+				next = j
+			}
+		}
+		visited[next] = true
+		order = append(order, next)
+	}
+
+	if err := twoOpt(ctx, closure.dist, order); err != nil {
+		return nil, err
+	}
+	return closure.tour(order), nil
+}
+
+func twoOpt(ctx context.Context, dist [][]graph.TWeight, order []int) error {
+	n := len(order)
+	d := func(a, b int) int64 { return int64(dist[order[a]][order[b]]) }
+
+	for improved := true; improved; {
+		improved = false
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		for i := 1; i < n-1; i++ {
+			for k := i + 1; k < n; k++ {
+				next := (k + 1) % n
+				if d(i-1, k)+d(i, next) < d(i-1, i)+d(k, next) {
+					slices.Reverse(order[i : k+1])
+					improved = true
+				}
+			}
+		}
+	}
+	return nil
+}
+
+/*
+ * Christofides-style heuristic: minimum spanning tree, plus matching of its
+ * odd-degree nodes, gives a graph with Eulerian circuit. The circuit with
+ * repeated nodes skipped is the tour. Real Christofides uses minimum-weight
+ * perfect matching and guarantees 1.5 approximation; here the matching is
+ * greedy, which is much faster but has no such guarantee.
+ */
+
+func ChristofidesTSP(ctx context.Context, gr *graph.Graph) (*Tour, error) {
+	closure, err := makeMetricClosure(ctx, gr)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(closure.keys)
+	if n == 0 {
+		return &Tour{}, nil
+	}
+
+	// Prim's MST on the closure
+	inTree := make([]bool, n)
+	best, from := make([]graph.TWeight, n), make([]int, n)
+	for i := range best {
+		best[i], from[i] = noEdge, -1
+	}
+	best[0] = 0
+	degree := make([]int, n)
+	edges := []walkEdge{}
+	for range n {
+		u := -1
+		for i := range n {
+			if !inTree[i] && (u == -1 || best[i] < best[u]) {
+				u = i
+			}
+		}
+		inTree[u] = true
+		if from[u] != -1 {
+			edges = append(edges, walkEdge{key: graph.TKey(len(edges)), src: graph.TKey(from[u]), dst: graph.TKey(u)})
+			degree[u]++
+			degree[from[u]]++
+		}
+		for v := range n {
+			if !inTree[v] && closure.dist[u][v] < best[v] {
+				best[v], from[v] = closure.dist[u][v], u
+			}
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Greedy matching of odd nodes, closest pairs first
+	odd := []int{}
+	for i := range n {
+		if degree[i]%2 != 0 {
+			odd = append(odd, i)
+		}
+	}
+	pairs := [][2]int{}
+	for i := range odd {
+		for j := i + 1; j < len(odd); j++ {
+			pairs = append(pairs, [2]int{odd[i], odd[j]})
+		}
+	}
+	slices.SortStableFunc(pairs, func(a, b [2]int) int {
+		da, db := closure.dist[a[0]][a[1]], closure.dist[b[0]][b[1]]
+		switch {
+		case da < db:
+			return -1
+		case da > db:
+			return 1
+		}
+		return 0
+	})
+	matched := make([]bool, n)
+	for _, pair := range pairs {
+		if !matched[pair[0]] && !matched[pair[1]] {
+			matched[pair[0]], matched[pair[1]] = true, true
+			edges = append(edges, walkEdge{key: graph.TKey(len(edges)), src: graph.TKey(pair[0]), dst: graph.TKey(pair[1])})
+		}
+	}
+
+	// Walk Eulerian circuit and skip visited nodes
+	circuit := hierholzer(edges, false, 0)
+	visited := make([]bool, n)
+	order := []int{0}
+	visited[0] = true
+	at := 0
+	for _, id := range circuit {
+		edge := edges[id]
+		at = int(edge.src) + int(edge.dst) - at
+		if !visited[at] {
+			visited[at] = true
+			order = append(order, at)
+		}
+	}
+
+	return closure.tour(order), nil
+}
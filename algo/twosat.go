@@ -0,0 +1,219 @@
+/*
+ * This package contains algorithms and tasks for my SSU course
+ */
+
+package algo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * 2-SAT: conjunction of clauses with at most two literals each. Variables
+ * are numbered from 1, literal x means variable x is true and -x means it
+ * is false, like in DIMACS CNF.
+ */
+
+type TwoSAT struct {
+	Variables int
+	Clauses   [][2]int // unit clause (x) is stored as (x or x)
+}
+
+/*
+ * Text format is DIMACS CNF with at most two literals per clause:
+ *
+ *	c comment (lines starting with # are comments too)
+ *	p cnf 3 2
+ *	1 -2 0
+ *	2 3 0
+ *
+ * Literals are read as one stream, every clause ends with 0, so a line may
+ * hold several clauses and a clause may go on the next line. Zero after the
+ * last clause may be omitted, line starting with % ends the formula (SATLIB
+ * files have it). Header is optional, but must come before clauses: without
+ * it number of variables is the biggest one met, with it literals must fit
+ * into it.
+ */
+
+func ParseTwoSAT(text string) (*TwoSAT, error) {
+	formula := &TwoSAT{Clauses: [][2]int{}}
+	declared := -1
+	clause := []int{}
+
+	addClause := func(line int) error {
+		if len(clause) == 0 || len(clause) > 2 {
+			return graph.ThrowInvalidFormula(line, fmt.Sprintf("clause must have 1 or 2 literals, got %d", len(clause)))
+		}
+		if len(clause) == 1 {
+			clause = append(clause, clause[0])
+		}
+		formula.Clauses = append(formula.Clauses, [2]int{clause[0], clause[1]})
+		clause = clause[:0]
+		return nil
+	}
+
+	last := 0
+	for i, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] == "c" || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if strings.HasPrefix(fields[0], "%") {
+			break
+		}
+
+		if fields[0] == "p" {
+			if declared != -1 || len(fields) != 4 || fields[1] != "cnf" {
+				return nil, graph.ThrowInvalidFormula(i+1, "expected single header \"p cnf <variables> <clauses>\"")
+			}
+			if len(formula.Clauses) > 0 || len(clause) > 0 {
+				return nil, graph.ThrowInvalidFormula(i+1, "header must come before clauses")
+			}
+			variables, err := strconv.Atoi(fields[2])
+			if err != nil || variables < 0 {
+				return nil, graph.ThrowInvalidFormula(i+1, "bad number of variables")
+			}
+			declared = variables
+			continue
+		}
+
+		last = i + 1
+		for _, field := range fields {
+			literal, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, graph.ThrowInvalidFormula(i+1, fmt.Sprintf("bad literal %q", field))
+			}
+			if literal == 0 {
+				if err := addClause(i + 1); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if declared != -1 && abs(literal) > declared {
+				return nil, graph.ThrowInvalidFormula(i+1, fmt.Sprintf("variable %d is out of declared %d", abs(literal), declared))
+			}
+			if len(clause) == 2 {
+				return nil, graph.ThrowInvalidFormula(i+1, "clause has more than 2 literals")
+			}
+			clause = append(clause, literal)
+			formula.Variables = max(formula.Variables, abs(literal))
+		}
+	}
+
+	if len(clause) > 0 {
+		if err := addClause(last); err != nil {
+			return nil, err
+		}
+	}
+	if declared != -1 {
+		formula.Variables = declared
+	}
+	return formula, nil
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Literal as text: x3 or ¬x3
+func FormatLiteral(literal int) string {
+	if literal < 0 {
+		return fmt.Sprintf("¬x%d", -literal)
+	}
+	return fmt.Sprintf("x%d", literal)
+}
+
+func (formula *TwoSAT) String() string {
+	clauses := make([]string, len(formula.Clauses))
+	for i, clause := range formula.Clauses {
+		if clause[0] == clause[1] {
+			clauses[i] = "(" + FormatLiteral(clause[0]) + ")"
+		} else {
+			clauses[i] = "(" + FormatLiteral(clause[0]) + " ∨ " + FormatLiteral(clause[1]) + ")"
+		}
+	}
+	return strings.Join(clauses, " ∧ ")
+}
+
+// Node key of literal in implication graph: 2x - 1 for x, 2x for ¬x
+func LiteralKey(literal int) graph.TKey {
+	if literal < 0 {
+		return graph.TKey(-2 * literal)
+	}
+	return graph.TKey(2*literal - 1)
+}
+
+/*
+ * Implication graph: clause (a or b) means ¬a → b and ¬b → a. It has a node
+ * for every literal, labelled like x3 or ¬x3, and edges labelled by clause
+ * number; duplicated clauses give parallel edges.
+ */
+
+func (formula *TwoSAT) ImplicationGraph() *graph.Graph {
+	gr := graph.MakeGraph(graph.WithGraphDirected(true), graph.WithGraphMulti(true))
+	for x := 1; x <= formula.Variables; x++ {
+		for _, literal := range []int{x, -x} {
+			key := LiteralKey(literal)
+			gr.Nodes[key] = graph.MakeNode(key, graph.WithNodeLabel(FormatLiteral(literal)))
+		}
+	}
+
+	edgeKey := graph.TKey(1)
+	for i, clause := range formula.Clauses {
+		label := graph.WithEdgeLabel(fmt.Sprintf("clause %d", i+1))
+		a, b := clause[0], clause[1]
+		gr.Edges[edgeKey] = graph.MakeEdge(edgeKey, LiteralKey(-a), LiteralKey(b), label)
+		edgeKey++
+		if a != b {
+			gr.Edges[edgeKey] = graph.MakeEdge(edgeKey, LiteralKey(-b), LiteralKey(a), label)
+			edgeKey++
+		}
+	}
+
+	gr.RebuildAdjacencyMap()
+	return gr
+}
+
+/*
+ * Formula is satisfiable iff no variable is in the same strongly connected
+ * component with its negation. Then x is true iff component of x comes
+ * after component of ¬x in topological order, that is x can not imply ¬x.
+ * Returns the assignment and 0, or nil and the smallest conflicting
+ * variable, for which x → ¬x → x. Linear in size of formula.
+ */
+
+func (formula *TwoSAT) Solve() (map[int]bool, int) {
+	membership, _ := StronglyConnectedTarjan(formula.ImplicationGraph())
+
+	assignment := make(map[int]bool, formula.Variables)
+	for x := 1; x <= formula.Variables; x++ {
+		positive, negative := membership[LiteralKey(x)], membership[LiteralKey(-x)]
+		if positive == negative {
+			return nil, x
+		}
+		assignment[x] = positive > negative
+	}
+	return assignment, 0
+}
+
+func (formula *TwoSAT) Satisfied(assignment map[int]bool) bool {
+	value := func(literal int) bool {
+		if literal < 0 {
+			return !assignment[-literal]
+		}
+		return assignment[literal]
+	}
+	for _, clause := range formula.Clauses {
+		if !value(clause[0]) && !value(clause[1]) {
+			return false
+		}
+	}
+	return true
+}
@@ -21,6 +21,30 @@ func (cli *CLIService) showAlgorithmsMenu() {
 		AddItem("In-Degree less than", "Find nodes with in-degree less than target", '1', cli.showInDegreeLessThanForm).
 		AddItem("In-nodes in directed", "Find nodes, that are in-nodes for target in directed graph", '2', cli.showIncomingNeighborsForm).
 		AddItem("Remove pendant", "Remove all pendant nodes. Destructive action", '3', cli.showRemovePendantVertices).
+		AddItem("Connected components", "Count connected, strongly and weakly connected components", '4', cli.showComponents).
+		AddItem("Topological sort", "Sort DAG, find cycle, longest path and count paths", '5', cli.showTopologicalSortForm).
+		AddItem("Articulation points & bridges", "Highlight single points of failure and biconnected blocks", '6', cli.showBiconnectivity).
+		AddItem("Maximum flow", "Find max flow and min cut between source and sink", '7', cli.showMaxFlowForm).
+		AddItem("Min-cost max flow", "Find the cheapest maximum flow using edge costs", '8', cli.showMinCostFlowForm).
+		AddItem("Assignment problem", "Solve weighted bipartite assignment (Hungarian)", '9', cli.showAssignmentForm).
+		AddItem("Bipartite & matching", "Check bipartiteness and find maximum matching", 'a', cli.showMatching).
+		AddItem("Eulerian path & postman", "Build Eulerian path/circuit and Chinese postman route", 'b', cli.showEulerian).
+		AddItem("Travelling salesman", "Find Hamiltonian path or TSP tour, exact or heuristic", 'c', cli.showTSPForm).
+		AddItem("Graph colouring", "Colour nodes and edges, find chromatic number", 'd', cli.showColoringForm).
+		AddItem("Cliques & covers", "Find cliques, independent sets and vertex covers", 'e', cli.showCliques).
+		AddItem("Centrality", "Rank nodes by degree, closeness, betweenness, PageRank, HITS", 'f', cli.showCentralityForm).
+		AddItem("Community detection", "Cluster nodes with Louvain, label propagation, Girvan-Newman", 'g', cli.showCommunityForm).
+		AddItem("Clustering & k-cores", "Count triangles, clustering coefficients and core numbers", 'h', cli.showClusteringForm).
+		AddItem("Degree statistics", "Show degree distribution, histogram and assortativity", 'i', cli.showDegreeForm).
+		AddItem("Degree sequence", "Test sequence by Erdős–Gallai and build graph by Havel–Hakimi", 'j', cli.showDegreeSequenceForm).
+		AddItem("Isomorphism", "Compare with a graph from file or find it as a pattern (VF2)", 'k', cli.showIsomorphismForm).
+		AddItem("Graph operations", "Union, intersection, difference, complement and products", 'l', cli.showCombineForm).
+		AddItem("Derived graphs", "Line graph, transpose, powers, transitive closure/reduction, subgraphs", 'm', cli.showDerivedGraphForm).
+		AddItem("Trees", "Tree check, Prüfer code, center, diameter, rooting and LCA", 'n', cli.showTreeForm).
+		AddItem("Dominators", "Dominator and post-dominator trees, frontiers and natural loops", 'o', cli.showDominatorsForm).
+		AddItem("Planarity", "Test planarity, show embedding or Kuratowski subgraph", 'p', cli.showPlanarity).
+		AddItem("2-SAT", "Solve 2-SAT formula from file via implication graph", 'r', cli.showTwoSATForm).
+		AddItem("Cycles", "Minimum mean cycle, elementary cycles and cycle bases", 's', cli.showCyclesForm).
 		AddItem("Back to Main Menu", "Return to main menu", 'q', func() {
 			cli.pages.SwitchToPage("main")
 		})
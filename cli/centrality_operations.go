@@ -0,0 +1,85 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func (cli *CLIService) showCentralityForm() {
+	form := tview.NewForm()
+	topText, dampingText := "10", "0.85"
+	weighted := false
+
+	form.AddInputField("Top N nodes", topText, 10, nil, func(text string) {
+		topText = text
+	})
+	form.AddInputField("PageRank damping", dampingText, 10, nil, func(text string) {
+		dampingText = text
+	})
+	form.AddCheckbox("Use edge weights as distances", false, func(checked bool) {
+		weighted = checked
+	})
+	form.AddButton("Run Algorithm", func() {
+		top, err := strconv.Atoi(topText)
+		if err != nil || top <= 0 {
+			cli.updateStatus("Error: Invalid N", Error)
+			return
+		}
+		damping, err := strconv.ParseFloat(dampingText, 64)
+		if err != nil || damping < 0 || damping > 1 {
+			cli.updateStatus("Error: Damping must be between 0 and 1", Error)
+			return
+		}
+
+		hubs, authorities := algo.HITS(cli.graph)
+		metrics := []struct {
+			title  string
+			scores map[graph.TKey]float64
+		}{
+			{"DEGREE", algo.DegreeCentrality(cli.graph)},
+			{"CLOSENESS", algo.ClosenessCentrality(cli.graph, weighted)},
+			{"HARMONIC", algo.HarmonicCentrality(cli.graph, weighted)},
+			{"BETWEENNESS (Brandes)", algo.BetweennessCentrality(cli.graph, weighted)},
+			{fmt.Sprintf("PAGERANK (damping %.2f)", damping), algo.PageRank(cli.graph, algo.WithDamping(damping))},
+			{"EIGENVECTOR", algo.EigenvectorCentrality(cli.graph)},
+			{"HITS HUBS", hubs},
+			{"HITS AUTHORITIES", authorities},
+		}
+
+		var info strings.Builder
+		for _, metric := range metrics {
+			info.WriteString(fmt.Sprintf("%s, TOP %d\n", metric.title, top))
+			info.WriteString(strings.Repeat("─", 50) + "\n")
+			for i, key := range algo.RankNodes(metric.scores) {
+				if i == top {
+					break
+				}
+				info.WriteString(fmt.Sprintf("#%-3d | Key: %4d | Label: %-20s | Score: %.6f\n",
+					i+1, key, cli.graph.Nodes[key].Label, metric.scores[key]))
+			}
+			info.WriteString("\n")
+		}
+
+		cli.showScrollableModal("Centrality", info.String(), "centrality")
+		cli.updateStatus("Centrality measures computed successfully", Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Centrality Measures ")
+	cli.pages.AddAndSwitchToPage("centrality", form, true)
+}
@@ -0,0 +1,53 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tolstovrob/graph-go/algo"
+)
+
+const cliquesShown = 50
+
+func (cli *CLIService) showCliques() {
+	var info strings.Builder
+
+	info.WriteString("CLIQUES\n")
+	info.WriteString(strings.Repeat("─", 50) + "\n")
+	if maximum, err := algo.MaximumClique(cli.graph); err != nil {
+		info.WriteString(fmt.Sprintf("Maximum clique: %v\n", err))
+	} else {
+		info.WriteString(fmt.Sprintf("Maximum clique (size %d): %s\n", len(maximum), formatKeys(maximum)))
+		cliques := algo.MaximalCliques(cli.graph)
+		info.WriteString(fmt.Sprintf("Maximal cliques: %d\n\n", len(cliques)))
+		for i, clique := range cliques[:min(len(cliques), cliquesShown)] {
+			info.WriteString(fmt.Sprintf("#%d | Size: %3d | Nodes: %s\n", i+1, len(clique), formatKeys(clique)))
+		}
+		if len(cliques) > cliquesShown {
+			info.WriteString(fmt.Sprintf("... and %d more\n", len(cliques)-cliquesShown))
+		}
+	}
+	info.WriteString("\n")
+
+	independent, exact := algo.MaximumIndependentSet(cli.graph)
+	info.WriteString("INDEPENDENT SET\n")
+	info.WriteString(strings.Repeat("─", 50) + "\n")
+	info.WriteString(fmt.Sprintf("%s (size %d): %s\n\n",
+		map[bool]string{true: "Maximum", false: "Greedy"}[exact], len(independent), formatKeys(independent)))
+
+	cover, exact := algo.MinimumVertexCover(cli.graph)
+	info.WriteString("VERTEX COVER\n")
+	info.WriteString(strings.Repeat("─", 50) + "\n")
+	info.WriteString(fmt.Sprintf("%s (size %d): %s\n",
+		map[bool]string{true: "Minimum", false: "2-approximation"}[exact], len(cover), formatKeys(cover)))
+
+	cli.showScrollableModal("Cliques and Covers", info.String(), "algorithms_menu")
+	cli.updateStatus("Cliques and covers found successfully", Success)
+}
@@ -0,0 +1,88 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+)
+
+func (cli *CLIService) showClusteringForm() {
+	form := tview.NewForm()
+	kText := "2"
+	replace := false
+
+	form.AddInputField("k for k-core", kText, 10, nil, func(text string) {
+		kText = text
+	})
+	form.AddCheckbox("Replace working graph with k-core", false, func(checked bool) {
+		replace = checked
+	})
+	form.AddButton("Run Algorithm", func() {
+		k, err := strconv.Atoi(kText)
+		if err != nil || k < 0 {
+			cli.updateStatus("Error: Invalid k", Error)
+			return
+		}
+
+		triangles := algo.Triangles(cli.graph)
+		local := algo.LocalClustering(cli.graph)
+		core := algo.CoreNumbers(cli.graph)
+		kCore := algo.KCore(cli.graph, k)
+
+		var info strings.Builder
+		info.WriteString("CLUSTERING\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("Triangles: %d\n", algo.TriangleCount(cli.graph)))
+		info.WriteString(fmt.Sprintf("Average clustering: %.6f\n", algo.AverageClustering(cli.graph)))
+		info.WriteString(fmt.Sprintf("Transitivity: %.6f\n\n", algo.Transitivity(cli.graph)))
+
+		info.WriteString("K-CORES\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		sizes := make(map[int]int)
+		degeneracy := 0
+		for _, number := range core {
+			sizes[number]++
+			degeneracy = max(degeneracy, number)
+		}
+		info.WriteString(fmt.Sprintf("Degeneracy (max core number): %d\n", degeneracy))
+		for number := 0; number <= degeneracy; number++ {
+			if sizes[number] > 0 {
+				info.WriteString(fmt.Sprintf("Core number %3d: %d nodes\n", number, sizes[number]))
+			}
+		}
+		info.WriteString(fmt.Sprintf("%d-core: %d nodes, %d edges\n\n", k, len(kCore.Nodes), len(kCore.Edges)))
+
+		info.WriteString("NODES\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		for _, key := range sortedKeys(core) {
+			info.WriteString(fmt.Sprintf("Key: %4d | Triangles: %4d | Clustering: %.4f | Core: %d\n",
+				key, triangles[key], local[key], core[key]))
+		}
+
+		status := "Clustering computed successfully"
+		if replace {
+			cli.graph = kCore
+			status = fmt.Sprintf("Graph replaced with its %d-core", k)
+		}
+
+		cli.showScrollableModal("Clustering and Cores", info.String(), "clustering")
+		cli.updateStatus(status, Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Clustering & k-Cores ")
+	cli.pages.AddAndSwitchToPage("clustering", form, true)
+}
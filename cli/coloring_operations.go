@@ -0,0 +1,89 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+var coloringMethods = []string{"DSatur", "Greedy (by key)", "Greedy (largest first)", "Greedy (smallest last)", "Exact (small graphs)"}
+
+func (cli *CLIService) showColoringForm() {
+	form := tview.NewForm()
+	method := coloringMethods[0]
+	store := false
+
+	form.AddDropDown("Method", coloringMethods, 0, func(option string, index int) {
+		method = option
+	})
+	form.AddCheckbox("Store as node attribute \"color\"", false, func(checked bool) {
+		store = checked
+	})
+	form.AddButton("Run Algorithm", func() {
+		var colors map[graph.TKey]int
+		var err error
+		switch method {
+		case "DSatur":
+			colors, err = algo.DSaturColoring(cli.graph)
+		case "Greedy (by key)":
+			colors, err = algo.GreedyColoring(cli.graph, algo.OrderNatural)
+		case "Greedy (largest first)":
+			colors, err = algo.GreedyColoring(cli.graph, algo.OrderLargestFirst)
+		case "Greedy (smallest last)":
+			colors, err = algo.GreedyColoring(cli.graph, algo.OrderSmallestLast)
+		case "Exact (small graphs)":
+			_, colors, err = algo.ChromaticNumber(cli.graph)
+		}
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		edgeColors, err := algo.EdgeColoring(cli.graph)
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		if store {
+			algo.WriteNodeAttribute(cli.graph, "color", colors)
+		}
+
+		var info strings.Builder
+		info.WriteString(fmt.Sprintf("NODE COLOURING (%s)\n", method))
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("Colours used: %d\n\n", algo.ColorCount(colors)))
+		for _, key := range sortedKeys(colors) {
+			info.WriteString(fmt.Sprintf("Key: %4d | Label: %-20s | Colour: %d\n", key, cli.graph.Nodes[key].Label, colors[key]))
+		}
+		info.WriteString("\n")
+
+		info.WriteString("EDGE COLOURING (greedy)\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("Colours used: %d\n\n", algo.ColorCount(edgeColors)))
+		for _, key := range sortedKeys(edgeColors) {
+			edge := cli.graph.Edges[key]
+			info.WriteString(fmt.Sprintf("Key: %4d | %4d — %4d | Colour: %d\n", key, edge.Source, edge.Destination, edgeColors[key]))
+		}
+
+		cli.showScrollableModal("Graph Colouring", info.String(), "coloring")
+		cli.updateStatus("Colouring completed successfully", Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Graph Colouring ")
+	cli.pages.AddAndSwitchToPage("coloring", form, true)
+}
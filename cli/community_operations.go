@@ -0,0 +1,82 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+var (
+	communityMethods = []string{"Louvain", "Label propagation", "Girvan-Newman (small graphs)"}
+	communityStores  = []string{"Don't store", "Node attribute \"community\"", "Node label"}
+)
+
+func (cli *CLIService) showCommunityForm() {
+	form := tview.NewForm()
+	method, store := communityMethods[0], communityStores[0]
+	weighted := false
+
+	form.AddDropDown("Method", communityMethods, 0, func(option string, index int) {
+		method = option
+	})
+	form.AddCheckbox("Use edge weights", false, func(checked bool) {
+		weighted = checked
+	})
+	form.AddDropDown("Store communities as", communityStores, 0, func(option string, index int) {
+		store = option
+	})
+	form.AddButton("Run Algorithm", func() {
+		options := []graph.Option[algo.CommunityOptions]{algo.WithWeighted(weighted)}
+
+		var partition map[graph.TKey]int
+		switch method {
+		case "Louvain":
+			partition = algo.Louvain(cli.graph, options...)
+		case "Label propagation":
+			partition = algo.LabelPropagation(cli.graph, options...)
+		case "Girvan-Newman (small graphs)":
+			var err error
+			if partition, err = algo.GirvanNewman(cli.graph, options...); err != nil {
+				cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+				return
+			}
+		}
+
+		switch store {
+		case "Node attribute \"community\"":
+			algo.WriteNodeAttribute(cli.graph, "community", partition)
+		case "Node label":
+			algo.WriteNodeLabel(cli.graph, "Community %d", partition)
+		}
+
+		groups := algo.GroupComponents(partition)
+		var info strings.Builder
+		info.WriteString(fmt.Sprintf("COMMUNITIES (%s)\n", method))
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("Count: %d\n", len(groups)))
+		info.WriteString(fmt.Sprintf("Modularity: %.4f\n\n", algo.Modularity(cli.graph, partition, options...)))
+		for i, group := range groups {
+			info.WriteString(fmt.Sprintf("#%d | Size: %4d | Nodes: %s\n", i+1, len(group), formatKeys(group)))
+		}
+
+		cli.showScrollableModal("Community Detection", info.String(), "community")
+		cli.updateStatus(fmt.Sprintf("Found %d communities", len(groups)), Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Community Detection ")
+	cli.pages.AddAndSwitchToPage("community", form, true)
+}
@@ -0,0 +1,61 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func (cli *CLIService) showComponents() {
+	var info strings.Builder
+
+	if cli.graph.Options.IsDirected {
+		strong, err := algo.StronglyConnectedTarjan(cli.graph)
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+		writeComponentsSection(&info, "STRONGLY CONNECTED COMPONENTS", strong)
+		writeComponentsSection(&info, "WEAKLY CONNECTED COMPONENTS", algo.WeaklyConnectedComponents(cli.graph))
+
+		condensed, _, err := algo.Condensation(cli.graph)
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+		info.WriteString("CONDENSATION\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("DAG with %d nodes and %d edges\n", len(condensed.Nodes), len(condensed.Edges)))
+	} else {
+		membership, err := algo.ConnectedComponents(cli.graph)
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+		writeComponentsSection(&info, "CONNECTED COMPONENTS", membership)
+	}
+
+	cli.showScrollableModal("Connected Components", info.String(), "algorithms_menu")
+	cli.updateStatus("Components computed successfully", Success)
+}
+
+func writeComponentsSection(info *strings.Builder, title string, membership map[graph.TKey]int) {
+	groups := algo.GroupComponents(membership)
+
+	info.WriteString(title + "\n")
+	info.WriteString(strings.Repeat("─", 50) + "\n")
+	info.WriteString(fmt.Sprintf("Count: %d (connected: %v)\n\n", len(groups), len(groups) <= 1))
+	for i, group := range groups {
+		info.WriteString(fmt.Sprintf("#%d | Size: %4d | Nodes: %s\n", i+1, len(group), formatKeys(group)))
+	}
+	info.WriteString("\n")
+}
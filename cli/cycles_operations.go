@@ -0,0 +1,122 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func (cli *CLIService) showCyclesForm() {
+	form := tview.NewForm()
+	limitText := "20"
+	weighted := false
+
+	form.AddInputField("Max cycles to list", limitText, 10, nil, func(text string) {
+		limitText = text
+	})
+	form.AddCheckbox("Weighted minimum basis", false, func(checked bool) {
+		weighted = checked
+	})
+	form.AddButton("Run Algorithm", func() {
+		limit, err := strconv.Atoi(strings.TrimSpace(limitText))
+		if err != nil || limit <= 0 {
+			cli.updateStatus("Error: Invalid limit", Error)
+			return
+		}
+
+		var info strings.Builder
+		if cli.graph.Options.IsDirected {
+			cycle, mean, err := algo.MinimumMeanCycle(cli.graph)
+			info.WriteString("MINIMUM MEAN CYCLE (KARP)\n")
+			info.WriteString(strings.Repeat("─", 50) + "\n")
+			if err != nil {
+				info.WriteString(fmt.Sprintf("%v\n\n", err))
+			} else if cycle == nil {
+				info.WriteString("Graph has no cycles\n\n")
+			} else {
+				info.WriteString(fmt.Sprintf("Mean weight: %.4f\n", mean))
+				cli.writeCycle(&info, 1, cycle)
+				info.WriteString("\n")
+			}
+
+			cycles, _ := algo.ElementaryCycles(cli.graph, limit)
+			cli.writeCycles(&info, "ELEMENTARY CYCLES (JOHNSON)", cycles, limit)
+			if len(cycles) == limit {
+				info.WriteString(fmt.Sprintf("Stopped at limit of %d cycles\n", limit))
+			}
+		} else {
+			basis, _ := algo.FundamentalCycleBasis(cli.graph)
+			cli.writeCycles(&info, "FUNDAMENTAL CYCLE BASIS (BFS TREE)", basis, limit)
+			info.WriteString("\n")
+
+			if basis, err := algo.MinimumCycleBasis(cli.graph, weighted); err != nil {
+				info.WriteString(fmt.Sprintf("Minimum cycle basis: %v\n", err))
+			} else {
+				cli.writeCycles(&info, "MINIMUM CYCLE BASIS (HORTON)", basis, limit)
+			}
+		}
+
+		cli.showScrollableModal("Cycles", info.String(), "cycles")
+		cli.updateStatus("Cycles found successfully", Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Cycles ")
+	cli.pages.AddAndSwitchToPage("cycles", form, true)
+}
+
+func (cli *CLIService) writeCycles(info *strings.Builder, title string, cycles [][]graph.TKey, limit int) {
+	info.WriteString(title + "\n")
+	info.WriteString(strings.Repeat("─", 50) + "\n")
+	if len(cycles) == 0 {
+		info.WriteString("No cycles\n")
+	}
+	for i, cycle := range cycles[:min(len(cycles), limit)] {
+		cli.writeCycle(info, i+1, cycle)
+	}
+	if len(cycles) > limit {
+		info.WriteString(fmt.Sprintf("... and %d more\n", len(cycles)-limit))
+	}
+}
+
+// Cycle as edge keys with the walk through its nodes
+func (cli *CLIService) writeCycle(info *strings.Builder, number int, cycle []graph.TKey) {
+	first := cli.graph.Edges[cycle[0]]
+	node := first.Source
+	if len(cycle) > 1 && !cli.graph.Options.IsDirected {
+		// Start from the end of the first edge not shared with the second
+		second := cli.graph.Edges[cycle[1]]
+		if node == second.Source || node == second.Destination {
+			node = first.Destination
+		}
+	}
+
+	weight := graph.TWeight(0)
+	walk := []string{fmt.Sprint(node)}
+	for _, key := range cycle {
+		edge := cli.graph.Edges[key]
+		if edge.Source == node {
+			node = edge.Destination
+		} else {
+			node = edge.Source
+		}
+		weight += edge.Weight
+		walk = append(walk, fmt.Sprint(node))
+	}
+	info.WriteString(fmt.Sprintf("%d. Edges: %s | Nodes: %s | Weight: %d\n",
+		number, formatKeys(cycle), strings.Join(walk, " → "), weight))
+}
@@ -0,0 +1,83 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func (cli *CLIService) showTopologicalSortForm() {
+	form := tview.NewForm()
+	var srcKey, dstKey string
+
+	form.AddInputField("Count paths from (optional)", "", 10, nil, func(text string) {
+		srcKey = text
+	})
+	form.AddInputField("Count paths to (optional)", "", 10, nil, func(text string) {
+		dstKey = text
+	})
+	form.AddButton("Run Algorithm", func() {
+		var info strings.Builder
+
+		kahn, err := algo.TopologicalSortKahn(cli.graph)
+		var notDAG *graph.GraphNotDAGError
+		if errors.As(err, &notDAG) {
+			info.WriteString("Graph is not a DAG. Found cycle:\n\n")
+			for i, edgeKey := range notDAG.Cycle {
+				edge := cli.graph.Edges[edgeKey]
+				info.WriteString(fmt.Sprintf("%d. Edge %d: %d → %d\n", i+1, edgeKey, edge.Source, edge.Destination))
+			}
+			cli.showScrollableModal("Topological Sort", info.String(), "topological_sort")
+			cli.updateStatus("Graph has a cycle", Error)
+			return
+		} else if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		dfs, _ := algo.TopologicalSortDFS(cli.graph)
+		info.WriteString(fmt.Sprintf("Kahn order: %s\n", formatKeys(kahn)))
+		info.WriteString(fmt.Sprintf("DFS order:  %s\n\n", formatKeys(dfs)))
+
+		path, length, _ := algo.LongestPathInDAG(cli.graph)
+		info.WriteString(fmt.Sprintf("Longest path: %s\nLength: %d\n", formatKeys(path), length))
+
+		if srcKey != "" || dstKey != "" {
+			src, errSrc := strconv.ParseUint(srcKey, 10, 64)
+			dst, errDst := strconv.ParseUint(dstKey, 10, 64)
+			if errSrc != nil || errDst != nil {
+				cli.updateStatus("Error: Invalid key format", Error)
+				return
+			}
+
+			count, err := algo.CountPathsInDAG(cli.graph, graph.TKey(src), graph.TKey(dst))
+			if err != nil {
+				cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+				return
+			}
+			info.WriteString(fmt.Sprintf("\nPaths from %d to %d: %s\n", src, dst, count.String()))
+		}
+
+		cli.showScrollableModal("Topological Sort", info.String(), "topological_sort")
+		cli.updateStatus("Algorithm completed successfully", Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Topological Sort ")
+	cli.pages.AddAndSwitchToPage("topological_sort", form, true)
+}
@@ -0,0 +1,145 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+)
+
+const histogramWidth = 40
+
+var degreeKinds = []string{"Total", "In", "Out"}
+
+func (cli *CLIService) showDegreeForm() {
+	form := tview.NewForm()
+	kind := algo.DegreeTotal
+	binsText := "10"
+
+	form.AddDropDown("Degree", degreeKinds, 0, func(option string, index int) {
+		kind = algo.DegreeKind(index)
+	})
+	form.AddInputField("Histogram bins", binsText, 10, nil, func(text string) {
+		binsText = text
+	})
+	form.AddButton("Run Algorithm", func() {
+		bins, err := strconv.Atoi(binsText)
+		if err != nil || bins <= 0 {
+			cli.updateStatus("Error: Invalid number of bins", Error)
+			return
+		}
+
+		stats := algo.DegreeStatistics(cli.graph, kind)
+		var info strings.Builder
+		info.WriteString(fmt.Sprintf("%s DEGREE STATISTICS\n", strings.ToUpper(degreeKinds[kind])))
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("Min: %d\n", stats.Min))
+		info.WriteString(fmt.Sprintf("Max: %d\n", stats.Max))
+		info.WriteString(fmt.Sprintf("Mean: %.4f\n", stats.Mean))
+		info.WriteString(fmt.Sprintf("Median: %.1f\n", stats.Median))
+		if r, ok := algo.DegreeAssortativity(cli.graph); ok {
+			info.WriteString(fmt.Sprintf("Assortativity: %.4f\n\n", r))
+		} else {
+			info.WriteString("Assortativity: undefined\n\n")
+		}
+
+		histogram := algo.DegreeHistogram(cli.graph, kind, bins)
+		largest := 0
+		for _, bin := range histogram {
+			largest = max(largest, bin.Count)
+		}
+		info.WriteString("HISTOGRAM\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		for _, bin := range histogram {
+			bar := 0
+			if largest > 0 {
+				bar = (bin.Count*histogramWidth + largest - 1) / largest
+			}
+			info.WriteString(fmt.Sprintf("%5d-%-5d | %-*s %d\n",
+				bin.From, bin.To, histogramWidth, strings.Repeat("█", bar), bin.Count))
+		}
+		info.WriteString("\n")
+
+		info.WriteString("DISTRIBUTION\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		for degree := stats.Min; degree <= stats.Max; degree++ {
+			if count := stats.Distribution[degree]; count > 0 {
+				info.WriteString(fmt.Sprintf("Degree: %4d | Nodes: %d\n", degree, count))
+			}
+		}
+
+		cli.showScrollableModal("Degree Statistics", info.String(), "degree_stats")
+		cli.updateStatus("Degree statistics computed successfully", Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Degree Statistics ")
+	cli.pages.AddAndSwitchToPage("degree_stats", form, true)
+}
+
+func (cli *CLIService) showDegreeSequenceForm() {
+	form := tview.NewForm()
+	var sequenceText string
+	replace := false
+
+	form.AddInputField("Degree sequence (e.g. 3,3,2,2,2)", "", 40, nil, func(text string) {
+		sequenceText = text
+	})
+	form.AddCheckbox("Replace working graph (Havel-Hakimi)", false, func(checked bool) {
+		replace = checked
+	})
+	form.AddButton("Check", func() {
+		values, err := parseKeys(sequenceText)
+		if err != nil || len(values) == 0 {
+			cli.updateStatus("Error: Invalid degree sequence", Error)
+			return
+		}
+		sequence := make([]int, len(values))
+		for i, value := range values {
+			sequence[i] = int(value)
+		}
+
+		gr, err := algo.HavelHakimi(sequence)
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		var info strings.Builder
+		info.WriteString("HAVEL-HAKIMI CONSTRUCTION\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("Sequence is graphical: %d nodes, %d edges\n\n", len(gr.Nodes), len(gr.Edges)))
+		for _, key := range sortedKeys(gr.Edges) {
+			edge := gr.Edges[key]
+			info.WriteString(fmt.Sprintf("Key: %4d | %4d — %4d\n", key, edge.Source, edge.Destination))
+		}
+
+		status := "Sequence is graphical"
+		if replace {
+			cli.graph = gr
+			status = "Graph replaced with Havel-Hakimi construction"
+		}
+
+		cli.showScrollableModal("Degree Sequence", info.String(), "degree_sequence")
+		cli.updateStatus(status, Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Degree Sequence ")
+	cli.pages.AddAndSwitchToPage("degree_sequence", form, true)
+}
@@ -0,0 +1,108 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+var derivedGraphs = []string{
+	"Line graph", "Transpose", "Power", "Transitive closure", "Transitive reduction",
+	"Induced subgraph", "Edge-induced subgraph",
+}
+
+func (cli *CLIService) showDerivedGraphForm() {
+	form := tview.NewForm()
+	derived := derivedGraphs[0]
+	argument := "2"
+	replace := false
+
+	form.AddDropDown("Graph", derivedGraphs, 0, func(option string, index int) {
+		derived = option
+	})
+	form.AddInputField("Power k or keys (e.g. 1, 2, 3)", argument, 30, nil, func(text string) {
+		argument = text
+	})
+	form.AddCheckbox("Replace working graph with result", false, func(checked bool) {
+		replace = checked
+	})
+	form.AddButton("Run", func() {
+		var result *graph.Graph
+		var err error
+
+		switch derived {
+		case "Line graph":
+			result = algo.LineGraph(cli.graph)
+		case "Transpose":
+			result, err = algo.Transpose(cli.graph)
+		case "Power":
+			var k int
+			if k, err = strconv.Atoi(strings.TrimSpace(argument)); err == nil {
+				result, err = algo.Power(cli.graph, k)
+			}
+		case "Transitive closure":
+			if n := len(cli.graph.Nodes); n > algo.TransitiveClosureNodesLimit {
+				err = graph.ThrowTooManyNodes(n, algo.TransitiveClosureNodesLimit)
+				break
+			}
+			result, err = algo.TransitiveClosure(cli.graph)
+		case "Transitive reduction":
+			result, err = algo.TransitiveReduction(cli.graph)
+		default:
+			var keys []graph.TKey
+			if keys, err = parseKeys(argument); err != nil {
+				break
+			}
+			if derived == "Induced subgraph" {
+				result, err = algo.InducedSubgraph(cli.graph, keys)
+			} else {
+				result, err = algo.EdgeInducedSubgraph(cli.graph, keys)
+			}
+		}
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		var info strings.Builder
+		info.WriteString(fmt.Sprintf("%s\n%s\nResult: %d nodes, %d edges (directed: %v, multi: %v)\n\n",
+			strings.ToUpper(derived), strings.Repeat("─", 50),
+			len(result.Nodes), len(result.Edges), result.Options.IsDirected, result.Options.IsMulti))
+		info.WriteString("EDGES\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		if len(result.Edges) == 0 {
+			info.WriteString("No edges\n")
+		}
+		for _, key := range sortedKeys(result.Edges) {
+			edge := result.Edges[key]
+			info.WriteString(fmt.Sprintf("Key: %4d | %d → %d | Weight: %d\n", key, edge.Source, edge.Destination, edge.Weight))
+		}
+
+		status := fmt.Sprintf("%s built successfully", derived)
+		if replace {
+			cli.graph = result
+			status = fmt.Sprintf("Graph replaced with %s", strings.ToLower(derived))
+		}
+
+		cli.showScrollableModal("Derived Graphs", info.String(), "derived")
+		cli.updateStatus(status, Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Derived Graphs ")
+	cli.pages.AddAndSwitchToPage("derived", form, true)
+}
@@ -0,0 +1,105 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func (cli *CLIService) showDominatorsForm() {
+	form := tview.NewForm()
+	entryText, exitText := "1", ""
+
+	form.AddInputField("Entry node key", entryText, 10, nil, func(text string) {
+		entryText = text
+	})
+	form.AddInputField("Exit node key (optional)", exitText, 10, nil, func(text string) {
+		exitText = text
+	})
+	form.AddButton("Run Algorithm", func() {
+		entry, err := strconv.ParseUint(strings.TrimSpace(entryText), 10, 64)
+		if err != nil {
+			cli.updateStatus("Error: Invalid entry key", Error)
+			return
+		}
+
+		tree, err := algo.DominatorTree(cli.graph, graph.TKey(entry), false)
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+		frontiers, _ := algo.DominanceFrontiers(cli.graph, graph.TKey(entry))
+		loops, _ := algo.NaturalLoops(cli.graph, graph.TKey(entry))
+
+		var info strings.Builder
+		info.WriteString(fmt.Sprintf("DOMINATOR TREE FROM %d\n", entry))
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		cli.writeRootedTree(&info, tree, tree.Root, "", "")
+		if unreachable := len(cli.graph.Nodes) - len(tree.Order); unreachable > 0 {
+			info.WriteString(fmt.Sprintf("Unreachable from entry: %d nodes\n", unreachable))
+		}
+		info.WriteString("\n")
+
+		info.WriteString("DOMINANCE FRONTIERS\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		for _, key := range sortedKeys(frontiers) {
+			info.WriteString(fmt.Sprintf("Key: %4d | Idom: %s | Frontier: %s\n",
+				key, formatIdom(tree, key), formatKeys(frontiers[key])))
+		}
+		info.WriteString("\n")
+
+		info.WriteString("NATURAL LOOPS\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		if len(loops) == 0 {
+			info.WriteString("No loops\n")
+		}
+		for _, loop := range loops {
+			info.WriteString(fmt.Sprintf("Header: %d | Back edges: %s | Nodes: %s\n",
+				loop.Header, formatKeys(loop.BackEdges), formatKeys(loop.Nodes)))
+		}
+
+		if strings.TrimSpace(exitText) != "" {
+			exit, err := strconv.ParseUint(strings.TrimSpace(exitText), 10, 64)
+			if err != nil {
+				cli.updateStatus("Error: Invalid exit key", Error)
+				return
+			}
+			postTree, err := algo.DominatorTree(cli.graph, graph.TKey(exit), true)
+			if err != nil {
+				cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+				return
+			}
+			info.WriteString(fmt.Sprintf("\nPOST-DOMINATOR TREE TO %d\n", exit))
+			info.WriteString(strings.Repeat("─", 50) + "\n")
+			cli.writeRootedTree(&info, postTree, postTree.Root, "", "")
+		}
+
+		cli.showScrollableModal("Dominators", info.String(), "dominators")
+		cli.updateStatus("Dominators computed successfully", Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Dominators ")
+	cli.pages.AddAndSwitchToPage("dominators", form, true)
+}
+
+func formatIdom(tree *algo.RootedTree, key graph.TKey) string {
+	if parent, ok := tree.Parent[key]; ok {
+		return fmt.Sprintf("%4d", parent)
+	}
+	return "   —"
+}
@@ -38,7 +38,7 @@ func (cli *CLIService) showEdgeOperations() {
 }
 func (cli *CLIService) showAddEdgeForm() {
 	form := tview.NewForm()
-	var edgeKey, srcKey, dstKey, weightStr, label string
+	var edgeKey, srcKey, dstKey, weightStr, costStr, label string
 
 	form.AddInputField("Edge Key", "", 10, nil, func(text string) {
 		edgeKey = text
@@ -52,6 +52,9 @@ func (cli *CLIService) showAddEdgeForm() {
 	form.AddInputField("Weight", "", 10, nil, func(text string) {
 		weightStr = text
 	})
+	form.AddInputField("Cost", "", 10, nil, func(text string) {
+		costStr = text
+	})
 	form.AddInputField("Label", "", 20, nil, func(text string) {
 		label = text
 	})
@@ -80,10 +83,19 @@ func (cli *CLIService) showAddEdgeForm() {
 			return
 		}
 
+		cost, err := strconv.ParseUint(costStr, 10, 64)
+		if costStr != "" && err != nil {
+			cli.updateStatus("Error: Invalid cost format", Error)
+			return
+		}
+
 		edge := graph.MakeEdge(graph.TKey(key), graph.TKey(src), graph.TKey(dst))
 		if weight > 0 {
 			edge.UpdateEdge(graph.WithEdgeWeight(graph.TWeight(weight)))
 		}
+		if cost > 0 {
+			edge.UpdateEdge(graph.WithEdgeCost(graph.TWeight(cost)))
+		}
 		if label != "" {
 			edge.UpdateEdge(graph.WithEdgeLabel(label))
 		}
@@ -134,7 +146,7 @@ func (cli *CLIService) showRemoveEdgeForm() {
 
 func (cli *CLIService) showModifyEdgeForm() {
 	form := tview.NewForm()
-	var key, weightStr, label string
+	var key, weightStr, costStr, label string
 
 	form.AddInputField("Edge Key", "", 10, nil, func(text string) {
 		key = text
@@ -142,6 +154,9 @@ func (cli *CLIService) showModifyEdgeForm() {
 	form.AddInputField("New Weight", "", 10, nil, func(text string) {
 		weightStr = text
 	})
+	form.AddInputField("New Cost", "", 10, nil, func(text string) {
+		costStr = text
+	})
 	form.AddInputField("New Label", "", 20, nil, func(text string) {
 		label = text
 	})
@@ -167,6 +182,15 @@ func (cli *CLIService) showModifyEdgeForm() {
 			edge.UpdateEdge(graph.WithEdgeWeight(graph.TWeight(weight)))
 		}
 
+		if costStr != "" {
+			cost, err := strconv.ParseUint(costStr, 10, 64)
+			if err != nil {
+				cli.updateStatus("Error: Invalid cost format", Error)
+				return
+			}
+			edge.UpdateEdge(graph.WithEdgeCost(graph.TWeight(cost)))
+		}
+
 		if label != "" {
 			edge.UpdateEdge(graph.WithEdgeLabel(label))
 		}
@@ -185,8 +209,8 @@ func (cli *CLIService) showModifyEdgeForm() {
 func (cli *CLIService) showEdgesList() {
 	edgesInfo := "Edges:\n\n"
 	for key, edge := range cli.graph.Edges {
-		edgesInfo += fmt.Sprintf("Key: %d, Source: %d -> Destination: %d, Weight: %d, Label: %s\n",
-			key, edge.Source, edge.Destination, edge.Weight, edge.Label)
+		edgesInfo += fmt.Sprintf("Key: %d, Source: %d -> Destination: %d, Weight: %d, Cost: %d, Label: %s\n",
+			key, edge.Source, edge.Destination, edge.Weight, edge.Cost, edge.Label)
 	}
 
 	cli.showScrollableModal("Edges List", edgesInfo, "edge_operations")
@@ -0,0 +1,59 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func (cli *CLIService) showEulerian() {
+	var info strings.Builder
+
+	info.WriteString("EULERIAN PATH AND CIRCUIT\n")
+	info.WriteString(strings.Repeat("─", 50) + "\n")
+	info.WriteString(fmt.Sprintf("Has Eulerian circuit: %v\n", algo.HasEulerianCircuit(cli.graph)))
+	info.WriteString(fmt.Sprintf("Has Eulerian path:    %v\n\n", algo.HasEulerianPath(cli.graph)))
+
+	if circuit, err := algo.EulerianCircuit(cli.graph); err == nil {
+		info.WriteString("Circuit:\n")
+		cli.writeEdgeRoute(&info, circuit)
+	} else if path, err := algo.EulerianPath(cli.graph); err == nil {
+		info.WriteString("Path:\n")
+		cli.writeEdgeRoute(&info, path)
+	}
+	info.WriteString("\n")
+
+	if !cli.graph.Options.IsDirected {
+		info.WriteString("CHINESE POSTMAN ROUTE\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		route, err := algo.ChinesePostman(cli.graph)
+		if err != nil {
+			info.WriteString(fmt.Sprintf("Cannot build route: %v\n", err))
+		} else {
+			info.WriteString(fmt.Sprintf("Cost: %d\n", route.Cost))
+			info.WriteString(fmt.Sprintf("Edges walked twice: %s\n\n", formatKeys(route.Duplicated)))
+			cli.writeEdgeRoute(&info, route.Edges)
+		}
+	}
+
+	cli.showScrollableModal("Eulerian Paths", info.String(), "algorithms_menu")
+	cli.updateStatus("Eulerian analysis completed successfully", Success)
+}
+
+// Writes route given as edge keys, one edge per line
+func (cli *CLIService) writeEdgeRoute(info *strings.Builder, route []graph.TKey) {
+	arrow := map[bool]string{true: "→", false: "—"}[cli.graph.Options.IsDirected]
+	for i, key := range route {
+		edge := cli.graph.Edges[key]
+		info.WriteString(fmt.Sprintf("%4d. Edge %4d: %4d %s %4d | Weight: %d\n", i+1, key, edge.Source, arrow, edge.Destination, edge.Weight))
+	}
+}
@@ -0,0 +1,181 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+var maxFlowAlgorithms = []string{"Dinic", "Edmonds-Karp", "Push-relabel"}
+
+func (cli *CLIService) showMaxFlowForm() {
+	form := tview.NewForm()
+	var srcKey, dstKey string
+	algorithm := maxFlowAlgorithms[0]
+
+	form.AddInputField("Source Node Key", "", 10, nil, func(text string) {
+		srcKey = text
+	})
+	form.AddInputField("Sink Node Key", "", 10, nil, func(text string) {
+		dstKey = text
+	})
+	form.AddDropDown("Algorithm", maxFlowAlgorithms, 0, func(option string, index int) {
+		algorithm = option
+	})
+	form.AddButton("Run Algorithm", func() {
+		src, errSrc := strconv.ParseUint(srcKey, 10, 64)
+		dst, errDst := strconv.ParseUint(dstKey, 10, 64)
+		if errSrc != nil || errDst != nil {
+			cli.updateStatus("Error: Invalid key format", Error)
+			return
+		}
+
+		var result *algo.MaxFlowResult
+		var err error
+		switch algorithm {
+		case "Dinic":
+			result, err = algo.MaxFlowDinic(cli.graph, graph.TKey(src), graph.TKey(dst))
+		case "Edmonds-Karp":
+			result, err = algo.MaxFlowEdmondsKarp(cli.graph, graph.TKey(src), graph.TKey(dst))
+		case "Push-relabel":
+			result, err = algo.MaxFlowPushRelabel(cli.graph, graph.TKey(src), graph.TKey(dst))
+		}
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		var info strings.Builder
+		info.WriteString(fmt.Sprintf("Maximum flow from %d to %d (%s): %d\n\n", src, dst, algorithm, result.Value))
+
+		info.WriteString("MINIMUM CUT\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("Source side: %s\n", formatKeys(result.SourceSide)))
+		info.WriteString(fmt.Sprintf("Sink side:   %s\n\n", formatKeys(result.SinkSide)))
+		for _, key := range result.CutEdges {
+			edge := cli.graph.Edges[key]
+			info.WriteString(fmt.Sprintf("Key: %4d | %4d → %4d | Capacity: %4d\n", key, edge.Source, edge.Destination, edge.Weight))
+		}
+		info.WriteString("\n")
+
+		info.WriteString("FLOW BY EDGES\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		for _, key := range sortedKeys(result.Flow) {
+			edge := cli.graph.Edges[key]
+			info.WriteString(fmt.Sprintf("Key: %4d | %4d → %4d | Flow: %4d / %4d\n",
+				key, edge.Source, edge.Destination, result.Flow[key], edge.Weight))
+		}
+
+		cli.showScrollableModal("Maximum Flow", info.String(), "max_flow")
+		cli.updateStatus("Algorithm completed successfully", Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Maximum Flow ")
+	cli.pages.AddAndSwitchToPage("max_flow", form, true)
+}
+
+func (cli *CLIService) showMinCostFlowForm() {
+	form := tview.NewForm()
+	var srcKey, dstKey string
+
+	form.AddInputField("Source Node Key", "", 10, nil, func(text string) {
+		srcKey = text
+	})
+	form.AddInputField("Sink Node Key", "", 10, nil, func(text string) {
+		dstKey = text
+	})
+	form.AddButton("Run Algorithm", func() {
+		src, errSrc := strconv.ParseUint(srcKey, 10, 64)
+		dst, errDst := strconv.ParseUint(dstKey, 10, 64)
+		if errSrc != nil || errDst != nil {
+			cli.updateStatus("Error: Invalid key format", Error)
+			return
+		}
+
+		result, err := algo.MinCostMaxFlow(cli.graph, graph.TKey(src), graph.TKey(dst))
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		var info strings.Builder
+		info.WriteString(fmt.Sprintf("Maximum flow from %d to %d: %d\n", src, dst, result.Value))
+		info.WriteString(fmt.Sprintf("Minimum cost: %d\n\n", result.Cost))
+
+		info.WriteString("FLOW BY EDGES\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		for _, key := range sortedKeys(result.Flow) {
+			edge := cli.graph.Edges[key]
+			info.WriteString(fmt.Sprintf("Key: %4d | %4d → %4d | Flow: %4d / %4d | Cost: %4d\n",
+				key, edge.Source, edge.Destination, result.Flow[key], edge.Weight, edge.Cost))
+		}
+
+		cli.showScrollableModal("Min-Cost Flow", info.String(), "min_cost_flow")
+		cli.updateStatus("Algorithm completed successfully", Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Min-Cost Max Flow ")
+	cli.pages.AddAndSwitchToPage("min_cost_flow", form, true)
+}
+
+func (cli *CLIService) showAssignmentForm() {
+	form := tview.NewForm()
+	var leftKeys string
+	var maximize bool
+
+	form.AddInputField("Left side keys (i.e. 1, 2, 3)", "", 30, nil, func(text string) {
+		leftKeys = text
+	})
+	form.AddCheckbox("Maximize weight", false, func(checked bool) {
+		maximize = checked
+	})
+	form.AddButton("Run Algorithm", func() {
+		left, err := parseKeys(leftKeys)
+		if err != nil || len(left) == 0 {
+			cli.updateStatus("Error: Invalid key list format", Error)
+			return
+		}
+
+		result, err := algo.HungarianAssignment(cli.graph, left, maximize)
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		var info strings.Builder
+		info.WriteString(fmt.Sprintf("Assigned pairs: %d\nTotal weight: %d\n\n", len(result.Pairs), result.Weight))
+		for _, key := range sortedKeys(result.Pairs) {
+			info.WriteString(fmt.Sprintf("%4d → %4d\n", key, result.Pairs[key]))
+		}
+		info.WriteString(fmt.Sprintf("\nEdges: %s\n", formatKeys(result.Edges)))
+
+		cli.showScrollableModal("Assignment", info.String(), "assignment")
+		cli.updateStatus("Algorithm completed successfully", Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Assignment Problem ")
+	cli.pages.AddAndSwitchToPage("assignment", form, true)
+}
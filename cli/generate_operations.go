@@ -0,0 +1,195 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/generate"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+var generators = []string{
+	"Erdős–Rényi G(n, p)", "Erdős–Rényi G(n, m)", "Barabási–Albert", "Watts–Strogatz",
+	"Random regular", "Random tree", "Complete", "Cycle", "Path", "Star", "Wheel",
+	"Grid", "Hypercube", "Petersen",
+}
+
+/*
+ * Generated graph is built at once, so its size is estimated before and
+ * limited. G(n, p) tries every pair of nodes, so its n is limited separately.
+ */
+
+const (
+	generateNodesLimit    = 100000
+	generateEdgesLimit    = 1000000
+	erdosRenyiPNodesLimit = 5000
+)
+
+func checkGeneratedSize(nodes, edges float64) error {
+	if nodes > generateNodesLimit {
+		return graph.ThrowResultTooBig("nodes", int(min(nodes, math.MaxInt32)), generateNodesLimit)
+	}
+	if edges > generateEdgesLimit {
+		return graph.ThrowResultTooBig("edges", int(min(edges, math.MaxInt32)), generateEdgesLimit)
+	}
+	return nil
+}
+
+func (cli *CLIService) showGenerateForm() {
+	form := tview.NewForm()
+	generator := generators[0]
+	nText, paramText, betaText, seedText, weightText := "10", "0.3", "0.1", "1", "0"
+	directed := false
+
+	form.AddDropDown("Generator", generators, 0, func(option string, index int) {
+		generator = option
+	})
+	form.AddInputField("n (rows for grid, dimension for hypercube)", nText, 10, nil, func(text string) {
+		nText = text
+	})
+	form.AddInputField("p, m, k, d or columns", paramText, 10, nil, func(text string) {
+		paramText = text
+	})
+	form.AddInputField("Rewiring probability (Watts–Strogatz)", betaText, 10, nil, func(text string) {
+		betaText = text
+	})
+	form.AddInputField("Seed", seedText, 20, nil, func(text string) {
+		seedText = text
+	})
+	form.AddInputField("Max weight (0 for unweighted)", weightText, 10, nil, func(text string) {
+		weightText = text
+	})
+	form.AddCheckbox("Directed", false, func(checked bool) {
+		directed = checked
+	})
+	form.AddButton("Generate", func() {
+		n, err := strconv.Atoi(strings.TrimSpace(nText))
+		if err != nil || n < 0 {
+			cli.updateStatus("Invalid n: must be a non-negative integer", Error)
+			return
+		}
+		seed, err := strconv.ParseUint(strings.TrimSpace(seedText), 10, 64)
+		if err != nil {
+			cli.updateStatus("Invalid seed", Error)
+			return
+		}
+		maxWeight, err := strconv.ParseUint(strings.TrimSpace(weightText), 10, 64)
+		if err != nil {
+			cli.updateStatus("Invalid max weight", Error)
+			return
+		}
+		options := []graph.Option[generate.Options]{
+			generate.WithDirected(directed),
+			generate.WithSeed(seed),
+			generate.WithWeights(1, graph.TWeight(maxWeight)),
+		}
+
+		// Number of pairs of nodes, that is edges of complete graph
+		pairs := float64(n) * float64(n-1) / 2
+		if directed {
+			pairs *= 2
+		}
+
+		var result *graph.Graph
+		param := strings.TrimSpace(paramText)
+		switch generator {
+		case "Erdős–Rényi G(n, p)":
+			var p float64
+			if p, err = strconv.ParseFloat(param, 64); err != nil {
+				break
+			}
+			if n > erdosRenyiPNodesLimit {
+				err = graph.ThrowTooManyNodes(n, erdosRenyiPNodesLimit)
+			} else if err = checkGeneratedSize(float64(n), p*pairs); err == nil {
+				result, err = generate.ErdosRenyi(n, p, options...)
+			}
+		case "Watts–Strogatz":
+			var k int
+			var beta float64
+			if k, err = strconv.Atoi(param); err != nil {
+				break
+			}
+			if beta, err = strconv.ParseFloat(strings.TrimSpace(betaText), 64); err != nil {
+				break
+			}
+			if err = checkGeneratedSize(float64(n), float64(n)*float64(k)/2); err == nil {
+				result, err = generate.WattsStrogatz(n, k, beta, options...)
+			}
+		case "Erdős–Rényi G(n, m)", "Barabási–Albert", "Random regular", "Grid":
+			var m int
+			if m, err = strconv.Atoi(param); err != nil {
+				break
+			}
+			switch generator {
+			case "Erdős–Rényi G(n, m)":
+				if err = checkGeneratedSize(float64(n), float64(m)); err == nil {
+					result, err = generate.ErdosRenyiM(n, m, options...)
+				}
+			case "Barabási–Albert":
+				if err = checkGeneratedSize(float64(n), float64(n)*float64(m)); err == nil {
+					result, err = generate.BarabasiAlbert(n, m, options...)
+				}
+			case "Random regular":
+				if err = checkGeneratedSize(float64(n), float64(n)*float64(m)/2); err == nil {
+					result, err = generate.RandomRegular(n, m, options...)
+				}
+			default:
+				if m < 0 {
+					err = graph.ThrowInvalidParameter("columns", "must be non-negative")
+				} else if err = checkGeneratedSize(float64(n)*float64(m), 2*float64(n)*float64(m)); err == nil {
+					result = generate.Grid(n, m, options...)
+				}
+			}
+		case "Complete":
+			if err = checkGeneratedSize(float64(n), pairs); err == nil {
+				result = generate.Complete(n, options...)
+			}
+		case "Random tree", "Cycle", "Path", "Star", "Wheel":
+			if err = checkGeneratedSize(float64(n), 2*float64(n)); err != nil {
+				break
+			}
+			switch generator {
+			case "Random tree":
+				result = generate.RandomTree(n, options...)
+			case "Cycle":
+				result = generate.Cycle(n, options...)
+			case "Path":
+				result = generate.Path(n, options...)
+			case "Star":
+				result = generate.Star(n, options...)
+			default:
+				result = generate.Wheel(n, options...)
+			}
+		case "Hypercube":
+			result, err = generate.Hypercube(n, options...)
+		case "Petersen":
+			result = generate.Petersen(options...)
+		}
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		cli.graph = result
+		cli.updateStatus(fmt.Sprintf("Generated %s: %d nodes, %d edges",
+			generator, len(result.Nodes), len(result.Edges)), Success)
+		cli.pages.SwitchToPage("main")
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("main")
+	})
+
+	form.SetBorder(true).SetTitle(" Generate Graph ")
+	cli.pages.AddAndSwitchToPage("generate", form, true)
+}
@@ -10,12 +10,14 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"sort"
 	"strings"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
 	"github.com/tolstovrob/graph-go/graph"
 )
 
@@ -82,6 +84,9 @@ func (cli *CLIService) getDetailedGraphInfo() string {
 	info.WriteString(fmt.Sprintf("Total Nodes: %d\n", len(cli.graph.Nodes)))
 	info.WriteString(fmt.Sprintf("Total Edges: %d\n\n", len(cli.graph.Edges)))
 
+	cli.writeStructuralMetrics(&info)
+	cli.writeSpectralMetrics(&info)
+
 	info.WriteString("NODES LIST\n")
 	info.WriteString(strings.Repeat("─", 50) + "\n")
 	if len(cli.graph.Nodes) == 0 {
@@ -96,8 +101,12 @@ func (cli *CLIService) getDetailedGraphInfo() string {
 		for _, key := range keys {
 			node := cli.graph.Nodes[key]
 			degree := len(cli.graph.AdjacencyMap[key])
-			info.WriteString(fmt.Sprintf("Key: %4d | Label: %-20s | Degree: %d\n",
+			info.WriteString(fmt.Sprintf("Key: %4d | Label: %-20s | Degree: %d",
 				key, node.Label, degree))
+			if len(node.Attributes) > 0 {
+				info.WriteString(fmt.Sprintf(" | %s", formatAttributes(node.Attributes)))
+			}
+			info.WriteString("\n")
 		}
 	}
 	info.WriteString("\n")
@@ -115,8 +124,8 @@ func (cli *CLIService) getDetailedGraphInfo() string {
 
 		for _, key := range keys {
 			edge := cli.graph.Edges[key]
-			info.WriteString(fmt.Sprintf("Key: %4d | %4d → %4d | Weight: %4d | Label: %s\n",
-				key, edge.Source, edge.Destination, edge.Weight, edge.Label))
+			info.WriteString(fmt.Sprintf("Key: %4d | %4d → %4d | Weight: %4d | Cost: %4d | Label: %s\n",
+				key, edge.Source, edge.Destination, edge.Weight, edge.Cost, edge.Label))
 		}
 	}
 	info.WriteString("\n")
@@ -151,6 +160,83 @@ func (cli *CLIService) getDetailedGraphInfo() string {
 	return info.String()
 }
 
+// All-pairs distances are too slow to compute on every info view of big graph
+const metricsNodesLimit = 1000
+
+func (cli *CLIService) writeStructuralMetrics(info *strings.Builder) {
+	info.WriteString("STRUCTURAL METRICS\n")
+	info.WriteString(strings.Repeat("─", 50) + "\n")
+	if len(cli.graph.Nodes) > metricsNodesLimit {
+		info.WriteString(fmt.Sprintf("Skipped: graph has more than %d nodes\n\n", metricsNodesLimit))
+		return
+	}
+
+	if girth, ok := algo.Girth(cli.graph); ok {
+		info.WriteString(fmt.Sprintf("Girth: %d\n", girth))
+	} else {
+		info.WriteString("Girth: no cycles\n")
+	}
+
+	for _, variant := range []struct {
+		name     string
+		weighted bool
+	}{{"hops", false}, {"weighted", true}} {
+		info.WriteString(fmt.Sprintf("\nDistances (%s)\n", variant.name))
+		average, stats := algo.AllPairsMetrics(cli.graph, variant.weighted)
+		info.WriteString(fmt.Sprintf("Average shortest path: %.4f\n", average))
+		if stats == nil {
+			info.WriteString("Eccentricities are infinite: graph is not (strongly) connected\n")
+			continue
+		}
+		info.WriteString(fmt.Sprintf("Diameter: %d\n", stats.Diameter))
+		info.WriteString(fmt.Sprintf("Radius: %d\n", stats.Radius))
+		info.WriteString(fmt.Sprintf("Center: %s\n", formatKeys(stats.Center)))
+		info.WriteString(fmt.Sprintf("Periphery: %s\n", formatKeys(stats.Periphery)))
+		info.WriteString("Eccentricity:\n")
+		for _, key := range sortedKeys(stats.Eccentricity) {
+			info.WriteString(fmt.Sprintf("Key: %4d | Eccentricity: %d\n", key, stats.Eccentricity[key]))
+		}
+	}
+	info.WriteString("\n")
+}
+
+func (cli *CLIService) writeSpectralMetrics(info *strings.Builder) {
+	info.WriteString("SPECTRAL METRICS\n")
+	info.WriteString(strings.Repeat("─", 50) + "\n")
+	if len(cli.graph.Nodes) > algo.SpectralNodesLimit {
+		info.WriteString(fmt.Sprintf("Skipped: graph has more than %d nodes\n\n", algo.SpectralNodesLimit))
+		return
+	}
+
+	adjacency, _ := algo.AdjacencySpectrum(cli.graph, false)
+	laplacian, _ := algo.LaplacianSpectrum(cli.graph, false)
+	connectivity, fiedler, _ := algo.AlgebraicConnectivity(cli.graph, false)
+	trees, _ := algo.SpanningTreeCount(cli.graph)
+
+	info.WriteString(fmt.Sprintf("Adjacency spectrum: %s\n", formatFloats(adjacency)))
+	info.WriteString(fmt.Sprintf("Laplacian spectrum: %s\n", formatFloats(laplacian)))
+	info.WriteString(fmt.Sprintf("Algebraic connectivity: %.6f\n", connectivity))
+	info.WriteString(fmt.Sprintf("Spanning trees: %s\n", trees.String()))
+	if len(fiedler) > 0 {
+		info.WriteString("Fiedler vector:\n")
+		for _, key := range sortedKeys(fiedler) {
+			info.WriteString(fmt.Sprintf("Key: %4d | Value: %9.6f\n", key, fiedler[key]))
+		}
+	}
+	info.WriteString("\n")
+}
+
+func formatFloats(values []float64) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		if math.Abs(value) < 5e-5 {
+			value = 0 // no "-0.0000" for rounding errors
+		}
+		parts[i] = fmt.Sprintf("%.4f", value)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
 func (cli *CLIService) showSaveJSONForm() {
 	form := tview.NewForm()
 	var filename string
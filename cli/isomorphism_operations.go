@@ -0,0 +1,105 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+const occurrencesShown = 20
+
+var isomorphismModes = []string{"Isomorphism", "Find pattern (subgraph)", "Find pattern (induced subgraph)"}
+
+func (cli *CLIService) showIsomorphismForm() {
+	form := tview.NewForm()
+	var filename string
+	mode := isomorphismModes[0]
+	matchLabels, matchWeights := false, false
+
+	form.AddInputField("Second graph file", "graph.json", 30, nil, func(text string) {
+		filename = text
+	})
+	form.AddDropDown("Mode", isomorphismModes, 0, func(option string, index int) {
+		mode = option
+	})
+	form.AddCheckbox("Match node labels", false, func(checked bool) {
+		matchLabels = checked
+	})
+	form.AddCheckbox("Match edge weights", false, func(checked bool) {
+		matchWeights = checked
+	})
+	form.AddButton("Run Algorithm", func() {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error reading file: %v", err), Error)
+			return
+		}
+		other := graph.MakeGraph()
+		if err := other.FromJSON(string(data)); err != nil {
+			cli.updateStatus(fmt.Sprintf("Error parsing JSON: %v", err), Error)
+			return
+		}
+
+		options := []graph.Option[algo.IsomorphismOptions]{}
+		if matchLabels {
+			options = append(options, algo.WithNodeMatch(algo.MatchNodeLabels))
+		}
+		if matchWeights {
+			options = append(options, algo.WithEdgeMatch(algo.MatchEdgeWeights))
+		}
+
+		var info strings.Builder
+		info.WriteString("GRAPHS\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("Working: %d nodes, %d edges, hash %.16s\n", len(cli.graph.Nodes), len(cli.graph.Edges), algo.CanonicalHash(cli.graph)))
+		info.WriteString(fmt.Sprintf("%s: %d nodes, %d edges, hash %.16s\n\n", filename, len(other.Nodes), len(other.Edges), algo.CanonicalHash(other)))
+
+		var mappings []map[graph.TKey]graph.TKey
+		if mode == "Isomorphism" {
+			info.WriteString("ISOMORPHISM (working → second)\n")
+			if mapping, ok := algo.Isomorphism(cli.graph, other, options...); ok {
+				mappings = append(mappings, mapping)
+			}
+		} else {
+			info.WriteString("PATTERN OCCURRENCES (pattern → working)\n")
+			options = append(options, algo.WithInduced(mode == "Find pattern (induced subgraph)"))
+			mappings = algo.AllSubgraphIsomorphisms(cli.graph, other, occurrencesShown, options...)
+		}
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+
+		if len(mappings) == 0 {
+			info.WriteString("No mapping found\n")
+		}
+		for i, mapping := range mappings {
+			pairs := make([]string, 0, len(mapping))
+			for _, key := range sortedKeys(mapping) {
+				pairs = append(pairs, fmt.Sprintf("%d→%d", key, mapping[key]))
+			}
+			info.WriteString(fmt.Sprintf("#%d | %s\n", i+1, strings.Join(pairs, ", ")))
+		}
+		if len(mappings) == occurrencesShown && mode != "Isomorphism" {
+			info.WriteString(fmt.Sprintf("Showing the first %d occurrences\n", occurrencesShown))
+		}
+
+		cli.showScrollableModal("Isomorphism", info.String(), "isomorphism")
+		cli.updateStatus(fmt.Sprintf("Found %d mapping(s)", len(mappings)), Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Graph Isomorphism ")
+	cli.pages.AddAndSwitchToPage("isomorphism", form, true)
+}
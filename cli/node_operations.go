@@ -141,7 +141,11 @@ func (cli *CLIService) showModifyNodeForm() {
 func (cli *CLIService) showNodesList() {
 	nodesInfo := "Nodes:\n\n"
 	for key, node := range cli.graph.Nodes {
-		nodesInfo += fmt.Sprintf("Key: %d, Label: %s\n", key, node.Label)
+		nodesInfo += fmt.Sprintf("Key: %d, Label: %s", key, node.Label)
+		if len(node.Attributes) > 0 {
+			nodesInfo += fmt.Sprintf(", Attributes: %s", formatAttributes(node.Attributes))
+		}
+		nodesInfo += "\n"
 	}
 
 	cli.showScrollableModal("Nodes List", nodesInfo, "node_operations")
@@ -0,0 +1,49 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tolstovrob/graph-go/algo"
+)
+
+func (cli *CLIService) showPlanarity() {
+	result, err := algo.Planarity(cli.graph)
+	if err != nil {
+		cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+		return
+	}
+
+	var info strings.Builder
+	info.WriteString("PLANARITY (LEFT-RIGHT TEST)\n")
+	info.WriteString(strings.Repeat("─", 50) + "\n")
+	if result.IsPlanar {
+		info.WriteString("Graph is planar\n\n")
+		info.WriteString("EMBEDDING (CLOCKWISE NEIGHBOURS)\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		for _, key := range sortedKeys(result.Embedding) {
+			info.WriteString(fmt.Sprintf("Key: %4d | %s\n", key, formatKeys(result.Embedding[key])))
+		}
+	} else if result.Kuratowski == nil {
+		info.WriteString("Graph is not planar\n")
+		info.WriteString(fmt.Sprintf("No Kuratowski witness: %s\n", result.Note))
+	} else {
+		info.WriteString("Graph is not planar\n\n")
+		info.WriteString(fmt.Sprintf("KURATOWSKI WITNESS: SUBDIVISION OF %s\n", result.Kind))
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		for i, key := range result.Kuratowski {
+			edge := cli.graph.Edges[key]
+			info.WriteString(fmt.Sprintf("%d. Edge %d: %d — %d\n", i+1, key, edge.Source, edge.Destination))
+		}
+	}
+
+	cli.showScrollableModal("Planarity", info.String(), "algorithms_menu")
+	cli.updateStatus("Planarity checked successfully", Success)
+}
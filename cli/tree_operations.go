@@ -0,0 +1,137 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func (cli *CLIService) showTreeForm() {
+	form := tview.NewForm()
+	rootText, pairsText, codeText := "1", "", ""
+	weighted := false
+
+	form.AddInputField("Root key", rootText, 10, nil, func(text string) {
+		rootText = text
+	})
+	form.AddInputField("LCA queries (e.g. 4 5; 2 7)", pairsText, 30, nil, func(text string) {
+		pairsText = text
+	})
+	form.AddCheckbox("Weighted diameter", false, func(checked bool) {
+		weighted = checked
+	})
+	form.AddInputField("Prüfer code to build (e.g. 2 2 1)", codeText, 30, nil, func(text string) {
+		codeText = text
+	})
+	form.AddButton("Run Algorithm", func() {
+		var info strings.Builder
+		info.WriteString("TREE CHECK\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		isTree := algo.IsTree(cli.graph)
+		info.WriteString(fmt.Sprintf("Tree: %v\nForest: %v\n\n", isTree, algo.IsForest(cli.graph)))
+		if !isTree {
+			cli.showScrollableModal("Trees", info.String(), "trees")
+			cli.updateStatus("Graph is not a tree", Error)
+			return
+		}
+
+		root, err := strconv.ParseUint(strings.TrimSpace(rootText), 10, 64)
+		if err != nil {
+			cli.updateStatus("Error: Invalid root key", Error)
+			return
+		}
+		rooted, err := algo.RootTree(cli.graph, graph.TKey(root))
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		code, _ := algo.PruferEncode(cli.graph)
+		center, _ := algo.TreeCenter(cli.graph)
+		path, length, _ := algo.TreeDiameter(cli.graph, weighted)
+		info.WriteString("STRUCTURE\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("Prüfer code: %s\n", formatKeys(code)))
+		info.WriteString(fmt.Sprintf("Center: %s\n", formatKeys(center)))
+		info.WriteString(fmt.Sprintf("Diameter: %d along %s\n\n", length, formatKeys(path)))
+
+		if strings.TrimSpace(pairsText) != "" {
+			info.WriteString("LOWEST COMMON ANCESTORS\n")
+			info.WriteString(strings.Repeat("─", 50) + "\n")
+			lca := algo.MakeLCA(rooted)
+			for _, pair := range strings.Split(pairsText, ";") {
+				keys, err := parseKeys(pair)
+				if err != nil || len(keys) != 2 {
+					cli.updateStatus(fmt.Sprintf("Error: Invalid pair %q", strings.TrimSpace(pair)), Error)
+					return
+				}
+				ancestor, err := lca.Query(keys[0], keys[1])
+				if err != nil {
+					cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+					return
+				}
+				distance, _ := lca.Distance(keys[0], keys[1])
+				info.WriteString(fmt.Sprintf("LCA(%d, %d) = %d | Distance: %d\n", keys[0], keys[1], ancestor, distance))
+			}
+			info.WriteString("\n")
+		}
+
+		info.WriteString(fmt.Sprintf("ROOTED AT %d\n", root))
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		cli.writeRootedTree(&info, rooted, rooted.Root, "", "")
+
+		cli.showScrollableModal("Trees", info.String(), "trees")
+		cli.updateStatus("Tree algorithms completed successfully", Success)
+	})
+
+	form.AddButton("Build from Prüfer code", func() {
+		code, err := parseKeys(codeText)
+		if err != nil {
+			cli.updateStatus("Error: Invalid Prüfer code", Error)
+			return
+		}
+		tree, err := algo.PruferDecode(code)
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+		cli.graph = tree
+		cli.updateStatus(fmt.Sprintf("Graph replaced with tree on %d nodes", len(tree.Nodes)), Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Trees ")
+	cli.pages.AddAndSwitchToPage("trees", form, true)
+}
+
+// Draws subtree with box-drawing branches, one node per line
+func (cli *CLIService) writeRootedTree(info *strings.Builder, tree *algo.RootedTree, key graph.TKey, branch, indent string) {
+	name := fmt.Sprintf("%d", key)
+	if label := cli.graph.Nodes[key].Label; label != "" {
+		name = fmt.Sprintf("%d (%s)", key, label)
+	}
+	info.WriteString(fmt.Sprintf("%s%s | Depth: %d\n", branch, name, tree.Depth[key]))
+
+	children := tree.Children[key]
+	for i, child := range children {
+		if i == len(children)-1 {
+			cli.writeRootedTree(info, tree, child, indent+"└── ", indent+"    ")
+		} else {
+			cli.writeRootedTree(info, tree, child, indent+"├── ", indent+"│   ")
+		}
+	}
+}
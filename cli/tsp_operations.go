@@ -0,0 +1,83 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+var tspMethods = []string{"Held-Karp (exact)", "Nearest neighbour + 2-opt", "Christofides-style", "Hamiltonian path (backtracking)"}
+
+func (cli *CLIService) showTSPForm() {
+	form := tview.NewForm()
+	method := tspMethods[0]
+	timeoutStr := "10"
+
+	form.AddDropDown("Method", tspMethods, 0, func(option string, index int) {
+		method = option
+	})
+	form.AddInputField("Timeout (seconds)", timeoutStr, 10, nil, func(text string) {
+		timeoutStr = text
+	})
+	form.AddButton("Run Algorithm", func() {
+		timeout, err := strconv.ParseUint(timeoutStr, 10, 64)
+		if err != nil || timeout == 0 {
+			cli.updateStatus("Error: Invalid timeout format", Error)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+		defer cancel()
+
+		var info strings.Builder
+		var tour *algo.Tour
+		switch method {
+		case "Held-Karp (exact)":
+			tour, err = algo.HeldKarpTSP(ctx, cli.graph)
+		case "Nearest neighbour + 2-opt":
+			tour, err = algo.NearestNeighbourTSP(ctx, cli.graph)
+		case "Christofides-style":
+			tour, err = algo.ChristofidesTSP(ctx, cli.graph)
+		case "Hamiltonian path (backtracking)":
+			var path []graph.TKey
+			path, err = algo.HamiltonianPath(ctx, cli.graph)
+			if err == nil {
+				info.WriteString(fmt.Sprintf("Hamiltonian path: %s\n", formatKeys(path)))
+			}
+		}
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		if tour != nil {
+			info.WriteString(fmt.Sprintf("Method: %s\n", method))
+			info.WriteString(fmt.Sprintf("Tour: %s\n", formatKeys(tour.Nodes)))
+			info.WriteString(fmt.Sprintf("Cost: %d\n\n", tour.Cost))
+			cli.writeEdgeRoute(&info, tour.Edges)
+		}
+
+		cli.showScrollableModal("Travelling Salesman", info.String(), "tsp")
+		cli.updateStatus("Algorithm completed successfully", Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" Travelling Salesman ")
+	cli.pages.AddAndSwitchToPage("tsp", form, true)
+}
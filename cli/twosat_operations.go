@@ -0,0 +1,88 @@
+/*
+ * This a CLI service for my graph implementation. It is build with tview and
+ * represents TUI CLI.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/algo"
+)
+
+func (cli *CLIService) showTwoSATForm() {
+	form := tview.NewForm()
+	filename := "formula.cnf"
+	loadGraph := false
+
+	form.AddInputField("Formula file (DIMACS CNF)", filename, 30, nil, func(text string) {
+		filename = text
+	})
+	form.AddCheckbox("Load implication graph as working graph", false, func(checked bool) {
+		loadGraph = checked
+	})
+	form.AddButton("Run Algorithm", func() {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error reading file: %v", err), Error)
+			return
+		}
+		formula, err := algo.ParseTwoSAT(string(data))
+		if err != nil {
+			cli.updateStatus(fmt.Sprintf("Error: %v", err), Error)
+			return
+		}
+
+		implication := formula.ImplicationGraph()
+		membership, _ := algo.StronglyConnectedTarjan(implication)
+		components := algo.GroupComponents(membership)
+
+		var info strings.Builder
+		info.WriteString("FORMULA\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("Variables: %d, clauses: %d\n", formula.Variables, len(formula.Clauses)))
+		info.WriteString(formula.String() + "\n\n")
+
+		info.WriteString("IMPLICATION GRAPH\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		info.WriteString(fmt.Sprintf("Nodes: %d, edges: %d, strongly connected components: %d\n\n",
+			len(implication.Nodes), len(implication.Edges), len(components)))
+
+		assignment, conflict := formula.Solve()
+		info.WriteString("SOLUTION\n")
+		info.WriteString(strings.Repeat("─", 50) + "\n")
+		if assignment != nil {
+			info.WriteString("Formula is satisfiable\n\n")
+			for x := 1; x <= formula.Variables; x++ {
+				info.WriteString(fmt.Sprintf("x%d = %v\n", x, assignment[x]))
+			}
+		} else {
+			info.WriteString("Formula is not satisfiable\n\n")
+			info.WriteString(fmt.Sprintf("Conflicting variable: x%d, it implies its negation and back\n", conflict))
+			literals := []string{}
+			for _, key := range components[membership[algo.LiteralKey(conflict)]-1] {
+				literals = append(literals, implication.Nodes[key].Label)
+			}
+			info.WriteString(fmt.Sprintf("Its component: %s\n", strings.Join(literals, ", ")))
+		}
+
+		if loadGraph {
+			cli.graph = implication
+		}
+		cli.showScrollableModal("2-SAT", info.String(), "twosat")
+		cli.updateStatus("2-SAT solved successfully", Success)
+	})
+
+	form.AddButton("Cancel", func() {
+		cli.pages.SwitchToPage("algorithms_menu")
+	})
+
+	form.SetBorder(true).SetTitle(" 2-SAT ")
+	cli.pages.AddAndSwitchToPage("twosat", form, true)
+}
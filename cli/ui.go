@@ -9,10 +9,13 @@ package cli
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/tolstovrob/graph-go/graph"
 )
 
 func (cli *CLIService) setupUI() {
@@ -50,6 +53,7 @@ func (cli *CLIService) createMainMenu() tview.Primitive {
 		AddItem("View Graph Info", "Display graph information", '4', cli.showGraphInfo).
 		AddItem("JSON Operations", "Save/Load graph from JSON", '5', cli.showJSONOperations).
 		AddItem("Algorithms", "Tasks from my SSU course", '6', cli.showAlgorithmsMenu).
+		AddItem("Generate Graph", "Build classic or random graph", '7', cli.showGenerateForm).
 		AddItem("Quit", "Exit application", 'q', func() {
 			cli.app.Stop()
 		})
@@ -87,3 +91,50 @@ func (cli *CLIService) showScrollableModal(title, content, returnPage string) {
 
 	cli.pages.AddAndSwitchToPage(strings.ToLower(strings.ReplaceAll(title, " ", "_"))+"_view", flex, true)
 }
+
+func formatKeys(keys []graph.TKey) string {
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = fmt.Sprintf("%d", key)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+func sortedKeys[V any](m map[graph.TKey]V) []graph.TKey {
+	keys := make([]graph.TKey, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Parses comma or space separated list of keys, i.e. "1, 2 3"
+func parseKeys(text string) ([]graph.TKey, error) {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	keys := make([]graph.TKey, 0, len(fields))
+	for _, field := range fields {
+		key, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, graph.TKey(key))
+	}
+	return keys, nil
+}
+
+func formatAttributes(attributes map[string]string) string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s=%s", name, attributes[name])
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,239 @@
+/*
+ * This package contains graph generators: classic families and random
+ * models. Every generator returns a new simple graph with node keys from 1
+ * to n and edge keys from 1 in order of generation.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package generate
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+/*
+ * Options are common for all generators. Random models and random weights
+ * use PCG seeded with Seed, so the same seed always gives the same graph.
+ * If MaxWeight is not zero, every edge gets weight uniformly taken from
+ * [MinWeight, MaxWeight], otherwise weights are zero.
+ *
+ * In directed graphs edges go from smaller key to bigger one, unless said
+ * otherwise for a generator.
+ */
+
+type Options struct {
+	Directed  bool
+	Seed      uint64
+	MinWeight graph.TWeight
+	MaxWeight graph.TWeight
+}
+
+func WithDirected(directed bool) graph.Option[Options] {
+	return func(opts *Options) {
+		opts.Directed = directed
+	}
+}
+
+func WithSeed(seed uint64) graph.Option[Options] {
+	return func(opts *Options) {
+		opts.Seed = seed
+	}
+}
+
+func WithWeights(minWeight, maxWeight graph.TWeight) graph.Option[Options] {
+	return func(opts *Options) {
+		opts.MinWeight, opts.MaxWeight = minWeight, maxWeight
+	}
+}
+
+// Accumulates nodes and edges of generated graph
+type builder struct {
+	gr      *graph.Graph
+	opts    Options
+	random  *rand.Rand
+	edgeKey graph.TKey
+	edges   map[[2]graph.TKey]bool
+}
+
+func makeBuilder(n int, options ...graph.Option[Options]) *builder {
+	opts := Options{}
+	for _, option := range options {
+		option(&opts)
+	}
+	b := &builder{
+		gr:      graph.MakeGraph(graph.WithGraphDirected(opts.Directed)),
+		opts:    opts,
+		random:  rand.New(rand.NewPCG(opts.Seed, opts.Seed)),
+		edgeKey: 1,
+		edges:   make(map[[2]graph.TKey]bool),
+	}
+	for key := range graph.TKey(max(n, 0)) {
+		b.gr.Nodes[key+1] = graph.MakeNode(key + 1)
+	}
+	return b
+}
+
+func (b *builder) ends(u, v graph.TKey) [2]graph.TKey {
+	if !b.opts.Directed && u > v {
+		u, v = v, u
+	}
+	return [2]graph.TKey{u, v}
+}
+
+func (b *builder) has(u, v graph.TKey) bool {
+	return b.edges[b.ends(u, v)]
+}
+
+// Adds edge u-v unless it is a self-loop or already exists
+func (b *builder) edge(u, v graph.TKey) bool {
+	if u == v || b.has(u, v) {
+		return false
+	}
+	b.edges[b.ends(u, v)] = true
+
+	var weight graph.TWeight
+	if b.opts.MaxWeight > 0 {
+		weight = b.opts.MinWeight
+		if b.opts.MaxWeight > b.opts.MinWeight {
+			weight += graph.TWeight(b.random.Uint64N(uint64(b.opts.MaxWeight-b.opts.MinWeight) + 1))
+		}
+	}
+	b.gr.Edges[b.edgeKey] = graph.MakeEdge(b.edgeKey, u, v, graph.WithEdgeWeight(weight))
+	b.edgeKey++
+	return true
+}
+
+func (b *builder) label(key graph.TKey, label string) {
+	b.gr.Nodes[key].Label = label
+}
+
+func (b *builder) build() *graph.Graph {
+	b.gr.RebuildAdjacencyMap()
+	return b.gr
+}
+
+// Complete graph K_n. Directed one has arcs both ways
+func Complete(n int, options ...graph.Option[Options]) *graph.Graph {
+	b := makeBuilder(n, options...)
+	for u := graph.TKey(1); u <= graph.TKey(n); u++ {
+		for v := u + 1; v <= graph.TKey(n); v++ {
+			b.edge(u, v)
+			if b.opts.Directed {
+				b.edge(v, u)
+			}
+		}
+	}
+	return b.build()
+}
+
+// Path 1-2-...-n
+func Path(n int, options ...graph.Option[Options]) *graph.Graph {
+	b := makeBuilder(n, options...)
+	for u := graph.TKey(1); u < graph.TKey(n); u++ {
+		b.edge(u, u+1)
+	}
+	return b.build()
+}
+
+// Cycle 1-2-...-n-1. Less than three nodes give a path (two in directed case)
+func Cycle(n int, options ...graph.Option[Options]) *graph.Graph {
+	b := makeBuilder(n, options...)
+	for u := graph.TKey(1); u < graph.TKey(n); u++ {
+		b.edge(u, u+1)
+	}
+	if n > 1 {
+		b.edge(graph.TKey(n), 1)
+	}
+	return b.build()
+}
+
+// Star with center 1 and n - 1 leaves
+func Star(n int, options ...graph.Option[Options]) *graph.Graph {
+	b := makeBuilder(n, options...)
+	for v := graph.TKey(2); v <= graph.TKey(n); v++ {
+		b.edge(1, v)
+	}
+	return b.build()
+}
+
+// Wheel: hub 1 connected to every node of cycle 2-3-...-n-2
+func Wheel(n int, options ...graph.Option[Options]) *graph.Graph {
+	b := makeBuilder(n, options...)
+	for v := graph.TKey(2); v <= graph.TKey(n); v++ {
+		b.edge(1, v)
+	}
+	for u := graph.TKey(2); u < graph.TKey(n); u++ {
+		b.edge(u, u+1)
+	}
+	if n > 3 {
+		b.edge(graph.TKey(n), 2)
+	}
+	return b.build()
+}
+
+/*
+ * Grid of rows x cols nodes. Node in row r and column c (from 0) has key
+ * r * cols + c + 1 and label "r,c". Directed edges go right and down.
+ */
+
+func Grid(rows, cols int, options ...graph.Option[Options]) *graph.Graph {
+	rows, cols = max(rows, 0), max(cols, 0)
+	b := makeBuilder(rows*cols, options...)
+	key := func(r, c int) graph.TKey { return graph.TKey(r*cols + c + 1) }
+	for r := range rows {
+		for c := range cols {
+			b.label(key(r, c), fmt.Sprintf("%d,%d", r, c))
+			if c+1 < cols {
+				b.edge(key(r, c), key(r, c+1))
+			}
+			if r+1 < rows {
+				b.edge(key(r, c), key(r+1, c))
+			}
+		}
+	}
+	return b.build()
+}
+
+/*
+ * Hypercube Q_d: 2^d nodes labeled by d-bit strings, adjacent if they differ
+ * in one bit. Node with bits x has key x + 1.
+ */
+
+const HypercubeMaxDimension = 20
+
+func Hypercube(d int, options ...graph.Option[Options]) (*graph.Graph, error) {
+	if d < 0 || d > HypercubeMaxDimension {
+		return nil, graph.ThrowInvalidParameter("d", fmt.Sprintf("must be from 0 to %d", HypercubeMaxDimension))
+	}
+
+	n := 1 << d
+	b := makeBuilder(n, options...)
+	for x := range n {
+		b.label(graph.TKey(x+1), fmt.Sprintf("%0*b", d, x))
+		for bit := range d {
+			if y := x ^ (1 << bit); y > x {
+				b.edge(graph.TKey(x+1), graph.TKey(y+1))
+			}
+		}
+	}
+	return b.build(), nil
+}
+
+// Petersen graph: outer cycle 1-5, inner pentagram 6-10, spokes i - i+5
+func Petersen(options ...graph.Option[Options]) *graph.Graph {
+	b := makeBuilder(10, options...)
+	for i := graph.TKey(0); i < 5; i++ {
+		b.edge(i+1, (i+1)%5+1)
+	}
+	for i := graph.TKey(0); i < 5; i++ {
+		b.edge(i+1, i+6)
+	}
+	for i := graph.TKey(0); i < 5; i++ {
+		b.edge(i+6, (i+2)%5+6)
+	}
+	return b.build()
+}
@@ -0,0 +1,278 @@
+/*
+ * This package contains graph generators: classic families and random
+ * models. Every generator returns a new simple graph with node keys from 1
+ * to n and edge keys from 1 in order of generation.
+ *
+ * Author: github.com/tolstovrob
+ */
+
+package generate
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func checkProbability(name string, p float64) error {
+	if p < 0 || p > 1 {
+		return graph.ThrowInvalidParameter(name, "must be from 0 to 1")
+	}
+	return nil
+}
+
+func checkNodes(n int) error {
+	if n < 0 {
+		return graph.ThrowInvalidParameter("n", "must be non-negative")
+	}
+	return nil
+}
+
+// Number of edges in complete graph on n nodes
+func maxEdges(n int, directed bool) int {
+	if directed {
+		return n * (n - 1)
+	}
+	return n * (n - 1) / 2
+}
+
+/*
+ * Erdős–Rényi G(n, p): every pair of nodes (ordered pair, if directed) is
+ * connected with probability p independently. O(n^2).
+ */
+
+func ErdosRenyi(n int, p float64, options ...graph.Option[Options]) (*graph.Graph, error) {
+	if err := checkNodes(n); err != nil {
+		return nil, err
+	}
+	if err := checkProbability("p", p); err != nil {
+		return nil, err
+	}
+
+	b := makeBuilder(n, options...)
+	for u := graph.TKey(1); u <= graph.TKey(n); u++ {
+		for v := graph.TKey(1); v <= graph.TKey(n); v++ {
+			if (b.opts.Directed && u != v || v > u) && b.random.Float64() < p {
+				b.edge(u, v)
+			}
+		}
+	}
+	return b.build(), nil
+}
+
+/*
+ * Erdős–Rényi G(n, m): m edges taken uniformly among all possible ones.
+ * Random pairs are drawn until m different edges are found, so it is fast
+ * even for m close to the maximum.
+ */
+
+func ErdosRenyiM(n, m int, options ...graph.Option[Options]) (*graph.Graph, error) {
+	if err := checkNodes(n); err != nil {
+		return nil, err
+	}
+	b := makeBuilder(n, options...)
+	if m < 0 || m > maxEdges(n, b.opts.Directed) {
+		return nil, graph.ThrowInvalidParameter("m", fmt.Sprintf("must be from 0 to %d", maxEdges(n, b.opts.Directed)))
+	}
+
+	for added := 0; added < m; {
+		u := graph.TKey(b.random.IntN(n) + 1)
+		v := graph.TKey(b.random.IntN(n) + 1)
+		if b.edge(u, v) {
+			added++
+		}
+	}
+	return b.build(), nil
+}
+
+/*
+ * Barabási–Albert preferential attachment: starting with m isolated nodes,
+ * every new node is connected to m different existing nodes, chosen with
+ * probability proportional to their degree. Directed edges go from new
+ * node to old ones. Gives scale-free graph with n - m new nodes and
+ * (n - m) * m edges.
+ */
+
+func BarabasiAlbert(n, m int, options ...graph.Option[Options]) (*graph.Graph, error) {
+	if err := checkNodes(n); err != nil {
+		return nil, err
+	}
+	if m < 1 || m >= n {
+		return nil, graph.ThrowInvalidParameter("m", "must be from 1 to n - 1")
+	}
+
+	b := makeBuilder(n, options...)
+	// Every node appears here once per incident edge, plus initial nodes once
+	repeated := make([]graph.TKey, 0, 2*n*m)
+	for v := graph.TKey(1); v <= graph.TKey(m); v++ {
+		repeated = append(repeated, v)
+	}
+
+	targets := make([]graph.TKey, 0, m)
+	for u := graph.TKey(m + 1); u <= graph.TKey(n); u++ {
+		targets = targets[:0]
+		for len(targets) < m {
+			v := repeated[b.random.IntN(len(repeated))]
+			if !slices.Contains(targets, v) {
+				targets = append(targets, v)
+			}
+		}
+		for _, v := range targets {
+			b.edge(u, v)
+			repeated = append(repeated, u, v)
+		}
+	}
+	return b.build(), nil
+}
+
+/*
+ * Watts–Strogatz small world: ring where every node is connected to k
+ * nearest ones (k / 2 on each side), then every edge u-v is rewired to u-w
+ * with probability beta, w taken uniformly among nodes not adjacent to u.
+ */
+
+func WattsStrogatz(n, k int, beta float64, options ...graph.Option[Options]) (*graph.Graph, error) {
+	if err := checkNodes(n); err != nil {
+		return nil, err
+	}
+	if k < 0 || k%2 != 0 || k >= n {
+		return nil, graph.ThrowInvalidParameter("k", "must be even and less than n")
+	}
+	if err := checkProbability("beta", beta); err != nil {
+		return nil, err
+	}
+
+	b := makeBuilder(n, options...)
+	node := func(i int) graph.TKey { return graph.TKey(i%n + 1) }
+	for j := 1; j <= k/2; j++ {
+		for i := range n {
+			b.edge(node(i), node(i+j))
+		}
+	}
+
+	// Number of nodes adjacent to every node, in any direction
+	adjacent := make([]int, n+1)
+	for _, edge := range b.gr.Edges {
+		adjacent[edge.Source]++
+		adjacent[edge.Destination]++
+	}
+
+	for key := graph.TKey(1); key < b.edgeKey; key++ {
+		edge := b.gr.Edges[key]
+		u, v := edge.Source, edge.Destination
+		// Node adjacent to everything cannot be rewired
+		if b.random.Float64() >= beta || adjacent[u] >= n-1 {
+			continue
+		}
+
+		w := u
+		for w == u || b.has(u, w) || b.has(w, u) {
+			w = graph.TKey(b.random.IntN(n) + 1)
+		}
+		delete(b.edges, b.ends(u, v))
+		if !b.has(v, u) {
+			adjacent[u]--
+			adjacent[v]--
+		}
+		b.edges[b.ends(u, w)] = true
+		adjacent[u]++
+		adjacent[w]++
+		edge.Destination = w
+	}
+	return b.build(), nil
+}
+
+/*
+ * Random d-regular graph by Steger–Wormald pairing: n * d half-edges are
+ * shuffled and paired, pairs making a self-loop or a parallel edge are
+ * put back and shuffled again. If no valid pair is left, generation starts
+ * over, up to RandomRegularAttempts times. Undirected only, n * d must be
+ * even.
+ */
+
+const RandomRegularAttempts = 100
+
+func RandomRegular(n, d int, options ...graph.Option[Options]) (*graph.Graph, error) {
+	if err := checkNodes(n); err != nil {
+		return nil, err
+	}
+	if d < 0 || d >= n || n*d%2 != 0 {
+		return nil, graph.ThrowInvalidParameter("d", "must be less than n, and n * d must be even")
+	}
+
+	options = slices.Clone(options)
+	for range RandomRegularAttempts {
+		b := makeBuilder(n, append(options, WithDirected(false))...)
+		if b.tryRegular(n, d) {
+			return b.build(), nil
+		}
+		// Next attempt must not repeat this one
+		options = append(options, WithSeed(b.random.Uint64()))
+	}
+	return nil, graph.ThrowInvalidParameter("d", "failed to build regular graph, try another seed")
+}
+
+func (b *builder) tryRegular(n, d int) bool {
+	stubs := make([]graph.TKey, 0, n*d)
+	for v := graph.TKey(1); v <= graph.TKey(n); v++ {
+		for range d {
+			stubs = append(stubs, v)
+		}
+	}
+
+	for len(stubs) > 0 {
+		b.random.Shuffle(len(stubs), func(i, j int) { stubs[i], stubs[j] = stubs[j], stubs[i] })
+		left := stubs[:0:0]
+		for i := 0; i+1 < len(stubs); i += 2 {
+			if !b.edge(stubs[i], stubs[i+1]) {
+				left = append(left, stubs[i], stubs[i+1])
+			}
+		}
+		if !b.canPair(left) {
+			return false
+		}
+		stubs = left
+	}
+	return true
+}
+
+// Whether some two of the left half-edges can still make an edge
+func (b *builder) canPair(stubs []graph.TKey) bool {
+	if len(stubs) == 0 {
+		return true
+	}
+	for i, u := range stubs {
+		for _, v := range stubs[i+1:] {
+			if u != v && !b.has(u, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/*
+ * Uniform random labeled tree: random Prüfer code of length n - 2, decoded
+ * by algo.PruferDecode. Directed edges go from the node removed in decoding
+ * to its neighbour.
+ */
+
+func RandomTree(n int, options ...graph.Option[Options]) *graph.Graph {
+	b := makeBuilder(n, options...)
+	if n < 2 {
+		return b.build()
+	}
+
+	code := make([]graph.TKey, n-2)
+	for i := range code {
+		code[i] = graph.TKey(b.random.IntN(n) + 1)
+	}
+	tree, _ := algo.PruferDecode(code) // code is always valid
+	for _, key := range slices.Sorted(maps.Keys(tree.Edges)) {
+		b.edge(tree.Edges[key].Source, tree.Edges[key].Destination)
+	}
+	return b.build()
+}
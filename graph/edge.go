@@ -28,6 +28,10 @@ package graph
  * or with optional fields:
  *
  * fullyConstructedEdge := MakeEdge(1, src.Key, dst.Key, WithEdgeLabel("Path"), WithEdgeWeight(69))
+ *
+ * Edge also has Cost. Usually Weight is enough, but flow problems need both
+ * capacity and price of a unit of flow, so Weight is capacity there and Cost
+ * is the price.
  */
 
 type Edge struct {
@@ -35,6 +39,7 @@ type Edge struct {
 	Source      TKey    `json:"source"`
 	Destination TKey    `json:"destination"`
 	Weight      TWeight `json:"weight"`
+	Cost        TWeight `json:"cost"`
 	Label       string  `json:"label"`
 }
 
@@ -64,3 +69,9 @@ func WithEdgeLabel(label string) Option[Edge] {
 		edge.Label = label
 	}
 }
+
+func WithEdgeCost(cost TWeight) Option[Edge] {
+	return func(edge *Edge) {
+		edge.Cost = cost
+	}
+}
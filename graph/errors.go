@@ -41,3 +41,88 @@ func ThrowGraphUnmarshalError() error {
 func ThrowGraphNotDirected() error {
 	return fmt.Errorf("Graph is not directed, but have to be")
 }
+
+func ThrowGraphDirected() error {
+	return fmt.Errorf("Graph is directed, but have to be undirected")
+}
+
+/*
+ * Error for algorithms which require directed acyclic graph. It carries the
+ * found cycle as edge keys in order of traversal, so caller can show it
+ */
+
+type GraphNotDAGError struct {
+	Cycle []TKey
+}
+
+func (err *GraphNotDAGError) Error() string {
+	return fmt.Sprintf("Graph is not a DAG, it has a cycle through edges %v", err.Cycle)
+}
+
+func ThrowGraphNotDAG(cycle []TKey) error {
+	return &GraphNotDAGError{Cycle: cycle}
+}
+
+func ThrowSourceIsSink(key TKey) error {
+	return fmt.Errorf("Node %v cannot be both source and sink", key)
+}
+
+func ThrowGraphNotBipartite() error {
+	return fmt.Errorf("Graph is not bipartite, but have to be")
+}
+
+func ThrowNoEulerianPath() error {
+	return fmt.Errorf("Graph has no Eulerian path")
+}
+
+func ThrowNoEulerianCircuit() error {
+	return fmt.Errorf("Graph has no Eulerian circuit")
+}
+
+func ThrowGraphNotConnected() error {
+	return fmt.Errorf("Graph is not connected, but have to be")
+}
+
+func ThrowTooManyNodes(count, limit int) error {
+	return fmt.Errorf("Graph has %d nodes, but the limit for this algorithm is %d", count, limit)
+}
+
+func ThrowTooManyEdges(count, limit int) error {
+	return fmt.Errorf("Graph has %d edges, but the limit for this algorithm is %d", count, limit)
+}
+
+func ThrowResultTooBig(what string, count, limit int) error {
+	return fmt.Errorf("Result would have %d %s, but the limit is %d", count, what, limit)
+}
+
+func ThrowNoHamiltonianPath() error {
+	return fmt.Errorf("Graph has no Hamiltonian path")
+}
+
+func ThrowNoHamiltonianCycle() error {
+	return fmt.Errorf("Graph has no Hamiltonian cycle")
+}
+
+func ThrowGraphHasLoop(key TKey) error {
+	return fmt.Errorf("Node %v has a self-loop, which is not allowed here", key)
+}
+
+func ThrowSequenceNotGraphical() error {
+	return fmt.Errorf("Degree sequence is not graphical: no simple graph has such degrees")
+}
+
+func ThrowDirectednessMismatch() error {
+	return fmt.Errorf("Graphs must be both directed or both undirected")
+}
+
+func ThrowInvalidParameter(name string, reason string) error {
+	return fmt.Errorf("Invalid parameter %s: %s", name, reason)
+}
+
+func ThrowGraphNotTree() error {
+	return fmt.Errorf("Graph is not a tree, but have to be")
+}
+
+func ThrowInvalidFormula(line int, reason string) error {
+	return fmt.Errorf("Invalid formula at line %d: %s", line, reason)
+}
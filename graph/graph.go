@@ -74,6 +74,9 @@ func (gr *Graph) Copy() *Graph {
 			Key:   node.Key,
 			Label: node.Label,
 		}
+		for name, value := range node.Attributes {
+			newGraph.Nodes[key].UpdateNode(WithNodeAttribute(name, value))
+		}
 	}
 
 	for key, edge := range gr.Edges {
@@ -82,6 +85,7 @@ func (gr *Graph) Copy() *Graph {
 			Source:      edge.Source,
 			Destination: edge.Destination,
 			Weight:      edge.Weight,
+			Cost:        edge.Cost,
 			Label:       edge.Label,
 		}
 	}
@@ -137,6 +141,7 @@ func (gr *Graph) RebuildEdges() {
 			Source:      edge.Source,
 			Destination: edge.Destination,
 			Weight:      edge.Weight,
+			Cost:        edge.Cost,
 			Label:       edge.Label,
 		}
 		newEdges[key] = newEdge
@@ -17,11 +17,17 @@ package graph
  * or this:
  *
  * labeledNode := MakeNode(1, WithNodeLabel("Aboba"))
+ *
+ * Node also may have string attributes. Algorithms use them to store their
+ * results in graph, i.e. node colour or community:
+ *
+ * colouredNode := MakeNode(1, WithNodeAttribute("color", "3"))
  */
 
 type Node struct {
-	Key   TKey   `json:"key"`
-	Label string `json:"label"`
+	Key        TKey              `json:"key"`
+	Label      string            `json:"label"`
+	Attributes map[string]string `json:"attributes,omitempty"`
 }
 
 func MakeNode(key TKey, options ...Option[Node]) *Node {
@@ -44,3 +50,12 @@ func WithNodeLabel(label string) Option[Node] {
 		node.Label = label
 	}
 }
+
+func WithNodeAttribute(name, value string) Option[Node] {
+	return func(node *Node) {
+		if node.Attributes == nil {
+			node.Attributes = make(map[string]string)
+		}
+		node.Attributes[name] = value
+	}
+}
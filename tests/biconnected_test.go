@@ -0,0 +1,44 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestArticulationPointsAndBridges(t *testing.T) {
+	// Triangle 1-2-3, bridge 3-4, triangle 4-5-6 and pendant 7 on 6
+	gr := buildGraph(t, false, false, 7,
+		[3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 1, 0},
+		[3]uint64{3, 4, 0},
+		[3]uint64{4, 5, 0}, [3]uint64{5, 6, 0}, [3]uint64{6, 4, 0},
+		[3]uint64{6, 7, 0})
+
+	if cuts := algo.ArticulationPoints(gr); !slices.Equal(cuts, []graph.TKey{3, 4, 6}) {
+		t.Errorf("Unexpected articulation points: %v", cuts)
+	}
+	if bridges := algo.Bridges(gr); !slices.Equal(bridges, []graph.TKey{4, 8}) {
+		t.Errorf("Unexpected bridges: %v", bridges)
+	}
+	if blocks := algo.BiconnectedComponents(gr); len(blocks) != 4 {
+		t.Errorf("Expected 4 blocks, got %d", len(blocks))
+	}
+
+	tree, cuts := algo.BlockCutTree(gr)
+	if len(tree.Nodes) != 7 || len(tree.Edges) != 6 || len(cuts) != 3 {
+		t.Errorf("Unexpected block-cut tree: %d nodes, %d edges", len(tree.Nodes), len(tree.Edges))
+	}
+}
+
+func TestBridgesWithParallelEdges(t *testing.T) {
+	gr := buildGraph(t, false, true, 3, [3]uint64{1, 2, 0}, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0})
+
+	if bridges := algo.Bridges(gr); !slices.Equal(bridges, []graph.TKey{3}) {
+		t.Errorf("Parallel edges must not be bridges, got %v", bridges)
+	}
+	if cuts := algo.ArticulationPoints(gr); !slices.Equal(cuts, []graph.TKey{2}) {
+		t.Errorf("Unexpected articulation points: %v", cuts)
+	}
+}
@@ -0,0 +1,84 @@
+package graph_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestDistanceCentralities(t *testing.T) {
+	// Star with center 1 and leaves 2..5
+	gr := buildGraph(t, false, false, 5, [3]uint64{1, 2, 1}, [3]uint64{1, 3, 1}, [3]uint64{1, 4, 1}, [3]uint64{1, 5, 1})
+
+	if degree := algo.DegreeCentrality(gr); !almostEqual(degree[1], 1) || !almostEqual(degree[2], 0.25) {
+		t.Errorf("Unexpected degree centrality %v", degree)
+	}
+	if closeness := algo.ClosenessCentrality(gr, false); !almostEqual(closeness[1], 1) || !almostEqual(closeness[2], 4.0/7) {
+		t.Errorf("Unexpected closeness %v", closeness)
+	}
+	if harmonic := algo.HarmonicCentrality(gr, false); !almostEqual(harmonic[1], 1) || !almostEqual(harmonic[2], 2.5/4) {
+		t.Errorf("Unexpected harmonic centrality %v", harmonic)
+	}
+	if betweenness := algo.BetweennessCentrality(gr, false); !almostEqual(betweenness[1], 6) || betweenness[2] != 0 {
+		t.Errorf("Unexpected betweenness %v", betweenness)
+	}
+}
+
+func TestWeightedBetweenness(t *testing.T) {
+	// Direct edge 1-3 is longer than path through 2
+	gr := buildGraph(t, false, false, 3, [3]uint64{1, 2, 1}, [3]uint64{2, 3, 1}, [3]uint64{1, 3, 5})
+
+	if betweenness := algo.BetweennessCentrality(gr, false); betweenness[2] != 0 {
+		t.Errorf("Node 2 should not be between when edges are unweighted, got %v", betweenness)
+	}
+	if betweenness := algo.BetweennessCentrality(gr, true); !almostEqual(betweenness[2], 1) {
+		t.Errorf("Node 2 should be between 1 and 3, got %v", betweenness)
+	}
+}
+
+func TestPageRank(t *testing.T) {
+	// Everyone links to 1, and 1 links to 2
+	gr := buildGraph(t, true, false, 4, [3]uint64{2, 1, 0}, [3]uint64{3, 1, 0}, [3]uint64{4, 1, 0}, [3]uint64{1, 2, 0})
+
+	rank := algo.PageRank(gr, algo.WithDamping(0.85), algo.WithTolerance(1e-10))
+	var sum float64
+	for _, score := range rank {
+		sum += score
+	}
+	if !almostEqual(sum, 1) {
+		t.Errorf("PageRank should sum up to 1, got %f", sum)
+	}
+	if order := algo.RankNodes(rank); order[0] != 1 || order[1] != 2 {
+		t.Errorf("Unexpected PageRank order %v", order)
+	}
+	if !almostEqual(rank[3], rank[4]) {
+		t.Errorf("Symmetric nodes should have equal rank, got %v", rank)
+	}
+}
+
+func TestEigenvectorAndHITS(t *testing.T) {
+	star := buildGraph(t, false, false, 4, [3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0})
+	if order := algo.RankNodes(algo.EigenvectorCentrality(star)); order[0] != 1 {
+		t.Errorf("Star center should be the most central, got %v", order)
+	}
+
+	// 1 and 2 are hubs pointing to authorities 3 and 4
+	gr := buildGraph(t, true, false, 4, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0}, [3]uint64{2, 3, 0}, [3]uint64{2, 4, 0})
+	hubs, authorities := algo.HITS(gr)
+	for _, key := range []graph.TKey{1, 2} {
+		if !almostEqual(hubs[key], 0.5) || authorities[key] != 0 {
+			t.Errorf("Node %d should be a pure hub, got hub %f, authority %f", key, hubs[key], authorities[key])
+		}
+	}
+	for _, key := range []graph.TKey{3, 4} {
+		if hubs[key] != 0 || !almostEqual(authorities[key], 0.5) {
+			t.Errorf("Node %d should be a pure authority, got hub %f, authority %f", key, hubs[key], authorities[key])
+		}
+	}
+}
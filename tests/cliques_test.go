@@ -0,0 +1,44 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/generate"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestCliques(t *testing.T) {
+	// K4 on 1..4, triangle 4-5-6 and edge 6-7
+	gr := buildGraph(t, true, false, 7,
+		[3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0}, [3]uint64{2, 3, 0}, [3]uint64{2, 4, 0}, [3]uint64{3, 4, 0},
+		[3]uint64{4, 5, 0}, [3]uint64{5, 6, 0}, [3]uint64{6, 4, 0}, [3]uint64{6, 7, 0})
+
+	if clique, err := algo.MaximumClique(gr); err != nil || !slices.Equal(clique, []graph.TKey{1, 2, 3, 4}) {
+		t.Errorf("Unexpected maximum clique %v (error %v)", clique, err)
+	}
+	if _, err := algo.MaximumClique(generate.Path(algo.ExactCoverNodesLimit + 1)); err == nil {
+		t.Error("Expected error for too big graph")
+	}
+
+	cliques := algo.MaximalCliques(gr)
+	if len(cliques) != 3 || !slices.Equal(cliques[2], []graph.TKey{6, 7}) {
+		t.Errorf("Unexpected maximal cliques %v", cliques)
+	}
+}
+
+func TestIndependentSetAndVertexCover(t *testing.T) {
+	// Path 1-2-3-4-5
+	gr := buildGraph(t, false, false, 5, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 5, 0})
+
+	independent, exact := algo.MaximumIndependentSet(gr)
+	if !exact || !slices.Equal(independent, []graph.TKey{1, 3, 5}) {
+		t.Errorf("Unexpected independent set %v", independent)
+	}
+
+	cover, exact := algo.MinimumVertexCover(gr)
+	if !exact || !slices.Equal(cover, []graph.TKey{2, 4}) {
+		t.Errorf("Unexpected vertex cover %v", cover)
+	}
+}
@@ -0,0 +1,62 @@
+package graph_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestTrianglesAndClustering(t *testing.T) {
+	// K4 on 1..4 with pendant 5 attached to 4, parallel edge 1-2 is ignored
+	gr := buildGraph(t, false, true, 5,
+		[3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0}, [3]uint64{2, 3, 0}, [3]uint64{2, 4, 0}, [3]uint64{3, 4, 0},
+		[3]uint64{4, 5, 0}, [3]uint64{1, 2, 0})
+
+	triangles := algo.Triangles(gr)
+	if triangles[1] != 3 || triangles[4] != 3 || triangles[5] != 0 || algo.TriangleCount(gr) != 4 {
+		t.Errorf("Unexpected triangles %v", triangles)
+	}
+
+	local := algo.LocalClustering(gr)
+	if local[1] != 1 || math.Abs(local[4]-0.5) > 1e-9 || local[5] != 0 {
+		t.Errorf("Unexpected local clustering %v", local)
+	}
+	if avg := algo.AverageClustering(gr); math.Abs(avg-3.5/5) > 1e-9 {
+		t.Errorf("Unexpected average clustering %f", avg)
+	}
+	// 12 closed triples of 3 * 3 + 6 = 15
+	if transitivity := algo.Transitivity(gr); math.Abs(transitivity-12.0/15) > 1e-9 {
+		t.Errorf("Unexpected transitivity %f", transitivity)
+	}
+}
+
+func TestKCore(t *testing.T) {
+	// K4 on 1..4, triangle 4-5-6 and path 6-7-8
+	gr := buildGraph(t, false, false, 8,
+		[3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0}, [3]uint64{2, 3, 0}, [3]uint64{2, 4, 0}, [3]uint64{3, 4, 0},
+		[3]uint64{4, 5, 0}, [3]uint64{5, 6, 0}, [3]uint64{6, 4, 0}, [3]uint64{6, 7, 0}, [3]uint64{7, 8, 0})
+
+	core := algo.CoreNumbers(gr)
+	expected := map[graph.TKey]int{1: 3, 2: 3, 3: 3, 4: 3, 5: 2, 6: 2, 7: 1, 8: 1}
+	for key, number := range expected {
+		if core[key] != number {
+			t.Errorf("Expected core number %d for node %d, got %d", number, key, core[key])
+		}
+	}
+
+	twoCore := algo.KCore(gr, 2)
+	keys := make([]graph.TKey, 0, len(twoCore.Nodes))
+	for key := range twoCore.Nodes {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	if !slices.Equal(keys, []graph.TKey{1, 2, 3, 4, 5, 6}) || len(twoCore.Edges) != 9 {
+		t.Errorf("Unexpected 2-core with nodes %v and %d edges", keys, len(twoCore.Edges))
+	}
+	if len(gr.Nodes) != 8 {
+		t.Error("KCore must not change the original graph")
+	}
+}
@@ -0,0 +1,84 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func checkColoring(t *testing.T, gr *graph.Graph, colors map[graph.TKey]int) {
+	t.Helper()
+	if len(colors) != len(gr.Nodes) {
+		t.Fatalf("Expected all %d nodes coloured, got %d", len(gr.Nodes), len(colors))
+	}
+	for _, edge := range gr.Edges {
+		if colors[edge.Source] == colors[edge.Destination] {
+			t.Errorf("Edge %d connects nodes of the same colour", edge.Key)
+		}
+	}
+}
+
+// Odd wheel: centre 1 and 5-cycle 2..6, chromatic number is 4
+func buildWheel(t *testing.T) *graph.Graph {
+	return buildGraph(t, false, false, 6,
+		[3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 5, 0}, [3]uint64{5, 6, 0}, [3]uint64{6, 2, 0},
+		[3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0}, [3]uint64{1, 5, 0}, [3]uint64{1, 6, 0})
+}
+
+func TestGreedyAndDSaturColoring(t *testing.T) {
+	gr := buildWheel(t)
+	for _, order := range []algo.ColoringOrder{algo.OrderNatural, algo.OrderLargestFirst, algo.OrderSmallestLast} {
+		colors, err := algo.GreedyColoring(gr, order)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		checkColoring(t, gr, colors)
+	}
+
+	colors, err := algo.DSaturColoring(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	checkColoring(t, gr, colors)
+	if algo.ColorCount(colors) != 4 {
+		t.Errorf("DSatur must colour odd wheel with 4 colours, got %d", algo.ColorCount(colors))
+	}
+
+	algo.WriteNodeAttribute(gr, "color", colors)
+	if gr.Nodes[1].Attributes["color"] == "" {
+		t.Errorf("Colour must be stored as node attribute")
+	}
+}
+
+func TestChromaticNumber(t *testing.T) {
+	count, colors, err := algo.ChromaticNumber(buildWheel(t))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if count != 4 || algo.ColorCount(colors) != 4 {
+		t.Errorf("Expected chromatic number 4, got %d", count)
+	}
+
+	even := buildGraph(t, false, false, 6,
+		[3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 5, 0}, [3]uint64{5, 6, 0}, [3]uint64{6, 1, 0})
+	if count, _, _ := algo.ChromaticNumber(even); count != 2 {
+		t.Errorf("Expected chromatic number 2 for even cycle, got %d", count)
+	}
+
+	loop := buildGraph(t, false, false, 1, [3]uint64{1, 1, 0})
+	if _, _, err := algo.ChromaticNumber(loop); err == nil {
+		t.Errorf("Expected error for graph with self-loop")
+	}
+}
+
+func TestEdgeColoring(t *testing.T) {
+	gr := buildGraph(t, false, true, 3, [3]uint64{1, 2, 0}, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0})
+	colors, err := algo.EdgeColoring(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if colors[1] == colors[2] || colors[2] == colors[3] || colors[1] == colors[3] {
+		t.Errorf("Adjacent edges share colour: %v", colors)
+	}
+}
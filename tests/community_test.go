@@ -0,0 +1,72 @@
+package graph_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/generate"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+// Two triangles 1-2-3 and 4-5-6 joined by bridge 3-4
+func twoTriangles(t *testing.T) *graph.Graph {
+	return buildGraph(t, false, false, 6,
+		[3]uint64{1, 2, 1}, [3]uint64{2, 3, 1}, [3]uint64{3, 1, 1},
+		[3]uint64{4, 5, 1}, [3]uint64{5, 6, 1}, [3]uint64{6, 4, 1},
+		[3]uint64{3, 4, 1})
+}
+
+func checkTwoTriangles(t *testing.T, name string, partition map[graph.TKey]int) {
+	t.Helper()
+	expected := map[graph.TKey]int{1: 1, 2: 1, 3: 1, 4: 2, 5: 2, 6: 2}
+	for key, community := range expected {
+		if partition[key] != community {
+			t.Errorf("%s: unexpected partition %v", name, partition)
+			return
+		}
+	}
+}
+
+func TestModularity(t *testing.T) {
+	gr := twoTriangles(t)
+
+	// Each triangle has 3 inner edges of 7 and degree sum 7 of 14
+	split := map[graph.TKey]int{1: 1, 2: 1, 3: 1, 4: 2, 5: 2, 6: 2}
+	if q := algo.Modularity(gr, split); math.Abs(q-(6.0/7-0.5)) > 1e-9 {
+		t.Errorf("Unexpected modularity %f", q)
+	}
+
+	whole := map[graph.TKey]int{1: 1, 2: 1, 3: 1, 4: 1, 5: 1, 6: 1}
+	if q := algo.Modularity(gr, whole); math.Abs(q) > 1e-9 {
+		t.Errorf("Single community should have zero modularity, got %f", q)
+	}
+}
+
+func TestCommunityDetection(t *testing.T) {
+	gr := twoTriangles(t)
+
+	checkTwoTriangles(t, "Louvain", algo.Louvain(gr))
+	checkTwoTriangles(t, "Label propagation", algo.LabelPropagation(gr))
+
+	partition, err := algo.GirvanNewman(gr)
+	if err != nil {
+		t.Fatalf("Girvan-Newman failed: %v", err)
+	}
+	checkTwoTriangles(t, "Girvan-Newman", partition)
+
+	if _, err := algo.GirvanNewman(generate.Complete(40)); err == nil {
+		t.Error("Expected error for too many edges")
+	}
+}
+
+func TestWeightedLouvain(t *testing.T) {
+	// Square 1-2-3-4 where heavy edges 1-2 and 3-4 should form communities
+	gr := buildGraph(t, false, false, 4,
+		[3]uint64{1, 2, 10}, [3]uint64{2, 3, 1}, [3]uint64{3, 4, 10}, [3]uint64{4, 1, 1})
+
+	partition := algo.Louvain(gr, algo.WithWeighted(true))
+	if partition[1] != partition[2] || partition[3] != partition[4] || partition[1] == partition[3] {
+		t.Errorf("Unexpected weighted partition %v", partition)
+	}
+}
@@ -0,0 +1,80 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestConnectedComponents(t *testing.T) {
+	gr := buildGraph(t, false, false, 6, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{4, 5, 0})
+	membership, err := algo.ConnectedComponents(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	groups := algo.GroupComponents(membership)
+	if len(groups) != 3 {
+		t.Fatalf("Expected 3 components, got %d", len(groups))
+	}
+	if membership[1] != membership[3] || membership[1] == membership[4] {
+		t.Errorf("Wrong membership: %v", membership)
+	}
+	if algo.IsConnected(gr) {
+		t.Errorf("Expected graph to be disconnected")
+	}
+
+	if _, err := algo.ConnectedComponents(buildGraph(t, true, false, 1)); err == nil {
+		t.Errorf("Expected error for directed graph")
+	}
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	// Two cycles 1-2-3 and 4-5 connected by 3->4, and lonely node 6
+	gr := buildGraph(t, true, false, 6,
+		[3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 1, 0},
+		[3]uint64{3, 4, 0}, [3]uint64{4, 5, 0}, [3]uint64{5, 4, 0})
+
+	tarjan, err := algo.StronglyConnectedTarjan(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	kosaraju, err := algo.StronglyConnectedKosaraju(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, membership := range []map[graph.TKey]int{tarjan, kosaraju} {
+		if len(algo.GroupComponents(membership)) != 3 {
+			t.Fatalf("Expected 3 components")
+		}
+		if membership[1] != membership[2] || membership[2] != membership[3] || membership[4] != membership[5] {
+			t.Errorf("Wrong membership: %v", membership)
+		}
+		if membership[1] >= membership[4] {
+			t.Errorf("Components are not in topological order: %v", membership)
+		}
+	}
+
+	if len(algo.GroupComponents(algo.WeaklyConnectedComponents(gr))) != 2 {
+		t.Errorf("Expected 2 weakly connected components")
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	gr := buildGraph(t, true, true, 4,
+		[3]uint64{1, 2, 0}, [3]uint64{2, 1, 0}, [3]uint64{2, 3, 0}, [3]uint64{1, 3, 0}, [3]uint64{3, 4, 0})
+	condensed, membership, err := algo.Condensation(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(condensed.Nodes) != 3 || len(condensed.Edges) != 2 {
+		t.Errorf("Expected 3 nodes and 2 edges, got %d and %d", len(condensed.Nodes), len(condensed.Edges))
+	}
+	if membership[1] != membership[2] {
+		t.Errorf("Nodes 1 and 2 must be in one component")
+	}
+	if ok, _ := algo.IsStronglyConnected(condensed); ok {
+		t.Errorf("Condensation must not be strongly connected")
+	}
+}
@@ -0,0 +1,257 @@
+package graph_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/generate"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+// Cycle must be a closed walk visiting every node once
+func checkCycle(t *testing.T, gr *graph.Graph, cycle []graph.TKey) {
+	t.Helper()
+	if len(cycle) == 0 {
+		t.Fatal("Empty cycle")
+	}
+	first := gr.Edges[cycle[0]]
+	starts := []graph.TKey{first.Source}
+	if !gr.Options.IsDirected {
+		starts = append(starts, first.Destination)
+	}
+
+	for _, start := range starts {
+		node, ok := start, true
+		visited := make(map[graph.TKey]bool)
+		for _, key := range cycle {
+			edge := gr.Edges[key]
+			if visited[node] {
+				ok = false
+				break
+			}
+			visited[node] = true
+			switch {
+			case edge.Source == node:
+				node = edge.Destination
+			case edge.Destination == node && !gr.Options.IsDirected:
+				node = edge.Source
+			default:
+				ok = false
+			}
+			if !ok {
+				break
+			}
+		}
+		if ok && node == start {
+			return
+		}
+	}
+	t.Fatalf("Edges %v do not form a cycle", cycle)
+}
+
+func cycleWeight(gr *graph.Graph, cycle []graph.TKey) graph.TWeight {
+	total := graph.TWeight(0)
+	for _, key := range cycle {
+		total += gr.Edges[key].Weight
+	}
+	return total
+}
+
+// Rank over GF(2) of cycles as edge sets
+func cycleRank(cycles [][]graph.TKey) int {
+	rows := []map[graph.TKey]bool{}
+	for _, cycle := range cycles {
+		row := make(map[graph.TKey]bool)
+		for _, key := range cycle {
+			row[key] = !row[key]
+		}
+		for _, other := range rows {
+			pivot := slices.Min(keysOf(other))
+			if row[pivot] {
+				for key := range other {
+					row[key] = !row[key]
+				}
+			}
+		}
+		for key, set := range row {
+			if !set {
+				delete(row, key)
+			}
+		}
+		if len(row) > 0 {
+			rows = append(rows, row)
+		}
+	}
+	return len(rows)
+}
+
+func keysOf(m map[graph.TKey]bool) []graph.TKey {
+	keys := []graph.TKey{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestMinimumMeanCycle(t *testing.T) {
+	// Cycle 1-2 has mean 4, cycle 3-4-5 has mean 2
+	gr := buildGraph(t, true, false, 6,
+		[3]uint64{1, 2, 4}, [3]uint64{2, 1, 4}, [3]uint64{2, 3, 0},
+		[3]uint64{3, 4, 1}, [3]uint64{4, 5, 2}, [3]uint64{5, 3, 3}, [3]uint64{5, 6, 0})
+	cycle, mean, err := algo.MinimumMeanCycle(gr)
+	if err != nil || !almostEqual(mean, 2) || len(cycle) != 3 {
+		t.Fatalf("Expected cycle of mean 2, got %v with mean %f (error %v)", cycle, mean, err)
+	}
+	checkCycle(t, gr, cycle)
+
+	gr.AddEdge(graph.MakeEdge(8, 6, 6, graph.WithEdgeWeight(1)))
+	if cycle, mean, _ := algo.MinimumMeanCycle(gr); !almostEqual(mean, 1) || !slices.Equal(cycle, []graph.TKey{8}) {
+		t.Errorf("Expected self-loop with mean 1, got %v with mean %f", cycle, mean)
+	}
+
+	if cycle, _, _ := algo.MinimumMeanCycle(generate.Path(5, generate.WithDirected(true))); cycle != nil {
+		t.Errorf("DAG has no cycles, got %v", cycle)
+	}
+	// Weights must fit into int64 arithmetic
+	heavy := buildGraph(t, true, false, 2, [3]uint64{1, 2, 1 << 62}, [3]uint64{2, 1, 1})
+	if _, _, err := algo.MinimumMeanCycle(heavy); err == nil {
+		t.Error("Expected error for too heavy edge")
+	}
+	heavy.Edges[1].Weight = 1 << 40
+	if _, mean, err := algo.MinimumMeanCycle(heavy); err != nil || !almostEqual(mean, float64(1<<40+1)/2) {
+		t.Errorf("Expected mean %f, got %f (error %v)", float64(1<<40+1)/2, mean, err)
+	}
+
+	if _, _, err := algo.MinimumMeanCycle(generate.Cycle(4)); err == nil {
+		t.Error("Expected error for undirected graph")
+	}
+
+	// Compare with all elementary cycles
+	for seed := range uint64(30) {
+		gr, _ := generate.ErdosRenyi(7, 0.3, generate.WithDirected(true), generate.WithSeed(seed), generate.WithWeights(1, 20))
+		cycles, _ := algo.ElementaryCycles(gr, 0)
+		cycle, mean, _ := algo.MinimumMeanCycle(gr)
+		if len(cycles) == 0 {
+			if cycle != nil {
+				t.Errorf("Seed %d: expected no cycle, got %v", seed, cycle)
+			}
+			continue
+		}
+		best := -1.0
+		for _, c := range cycles {
+			if m := float64(cycleWeight(gr, c)) / float64(len(c)); best < 0 || m < best {
+				best = m
+			}
+		}
+		checkCycle(t, gr, cycle)
+		if !almostEqual(mean, best) || !almostEqual(float64(cycleWeight(gr, cycle))/float64(len(cycle)), best) {
+			t.Errorf("Seed %d: expected mean %f, got %f for %v", seed, best, mean, cycle)
+		}
+	}
+}
+
+func TestElementaryCycles(t *testing.T) {
+	// Complete digraph on 4 nodes: 6 cycles of 2, 8 of 3 and 6 of 4 nodes
+	complete := generate.Complete(4, generate.WithDirected(true))
+	cycles, err := algo.ElementaryCycles(complete, 0)
+	if err != nil || len(cycles) != 20 {
+		t.Fatalf("Expected 20 cycles, got %d (error %v)", len(cycles), err)
+	}
+	seen := make(map[string]bool)
+	for _, cycle := range cycles {
+		checkCycle(t, complete, cycle)
+		id := fmt.Sprint(cycle)
+		if seen[id] {
+			t.Errorf("Cycle %v found twice", cycle)
+		}
+		seen[id] = true
+	}
+
+	if cycles, _ := algo.ElementaryCycles(complete, 5); len(cycles) != 5 {
+		t.Errorf("Limit 5 should give 5 cycles, got %d", len(cycles))
+	}
+
+	// Parallel edges make different cycles, self-loop is a cycle too
+	multi := buildGraph(t, true, true, 3,
+		[3]uint64{1, 2, 0}, [3]uint64{1, 2, 0}, [3]uint64{2, 1, 0}, [3]uint64{3, 3, 0}, [3]uint64{2, 3, 0})
+	cycles, _ = algo.ElementaryCycles(multi, 0)
+	expected := [][]graph.TKey{{1, 3}, {2, 3}, {4}}
+	if !slices.EqualFunc(cycles, expected, slices.Equal) {
+		t.Errorf("Expected cycles %v, got %v", expected, cycles)
+	}
+
+	if _, err := algo.ElementaryCycles(generate.Cycle(3), 0); err == nil {
+		t.Error("Expected error for undirected graph")
+	}
+}
+
+func TestCycleBasis(t *testing.T) {
+	multi := buildGraph(t, false, true, 4,
+		[3]uint64{1, 2, 1}, [3]uint64{2, 3, 1}, [3]uint64{3, 1, 1}, [3]uint64{3, 4, 1}, [3]uint64{3, 4, 1}, [3]uint64{4, 4, 1})
+	tests := []struct {
+		name    string
+		gr      *graph.Graph
+		minimum graph.TWeight // total length of minimum basis
+	}{
+		{"K4", generate.Complete(4), 9},
+		{"Grid", generate.Grid(3, 3), 16},
+		{"Petersen", generate.Petersen(), 30},
+		{"Tree", generate.RandomTree(10), 0},
+		{"Multigraph", multi, 6},
+		{"Two triangles", buildGraph(t, false, false, 6,
+			[3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 1, 0},
+			[3]uint64{4, 5, 0}, [3]uint64{5, 6, 0}, [3]uint64{6, 4, 0}), 6},
+	}
+	for _, test := range tests {
+		components := len(algo.GroupComponents(algo.WeaklyConnectedComponents(test.gr)))
+		dimension := len(test.gr.Edges) - len(test.gr.Nodes) + components
+
+		fundamental, err := algo.FundamentalCycleBasis(test.gr)
+		if err != nil {
+			t.Fatalf("%s: fundamental basis failed: %v", test.name, err)
+		}
+		minimum, err := algo.MinimumCycleBasis(test.gr, false)
+		if err != nil {
+			t.Fatalf("%s: minimum basis failed: %v", test.name, err)
+		}
+
+		total := graph.TWeight(0)
+		for _, basis := range [][][]graph.TKey{fundamental, minimum} {
+			if len(basis) != dimension || cycleRank(basis) != dimension {
+				t.Errorf("%s: expected %d independent cycles, got %d", test.name, dimension, len(basis))
+			}
+			for _, cycle := range basis {
+				checkCycle(t, test.gr, cycle)
+			}
+		}
+		for _, cycle := range minimum {
+			total += graph.TWeight(len(cycle))
+		}
+		if total != test.minimum {
+			t.Errorf("%s: expected minimum basis of length %d, got %d: %v", test.name, test.minimum, total, minimum)
+		}
+	}
+
+	// Square 1-2-3-4 with diagonals 2-4 and heavy 1-3: two light triangles
+	// and one of weight 12 through 1-3, the square itself is their sum
+	square := buildGraph(t, false, false, 4,
+		[3]uint64{1, 2, 1}, [3]uint64{2, 3, 1}, [3]uint64{3, 4, 1}, [3]uint64{4, 1, 1}, [3]uint64{1, 3, 10}, [3]uint64{2, 4, 1})
+	basis, _ := algo.MinimumCycleBasis(square, true)
+	total := graph.TWeight(0)
+	for _, cycle := range basis {
+		total += cycleWeight(square, cycle)
+	}
+	if len(basis) != 3 || total != 3+3+12 {
+		t.Errorf("Expected weighted basis of weight 18, got %d: %v", total, basis)
+	}
+
+	if _, err := algo.FundamentalCycleBasis(generate.Cycle(3, generate.WithDirected(true))); err == nil {
+		t.Error("Expected error for directed graph")
+	}
+	big := generate.Path(algo.CycleBasisNodesLimit + 1)
+	if _, err := algo.MinimumCycleBasis(big, false); err == nil {
+		t.Error("Expected error for too big graph")
+	}
+}
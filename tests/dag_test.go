@@ -0,0 +1,76 @@
+package graph_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	gr := buildGraph(t, true, false, 5,
+		[3]uint64{3, 1, 0}, [3]uint64{1, 2, 0}, [3]uint64{3, 2, 0}, [3]uint64{5, 4, 0})
+
+	kahn, err := algo.TopologicalSortKahn(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !slices.Equal(kahn, []graph.TKey{3, 1, 2, 5, 4}) {
+		t.Errorf("Unexpected Kahn order: %v", kahn)
+	}
+
+	dfs, err := algo.TopologicalSortDFS(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	position := make(map[graph.TKey]int)
+	for i, key := range dfs {
+		position[key] = i
+	}
+	for _, edge := range gr.Edges {
+		if position[edge.Source] > position[edge.Destination] {
+			t.Errorf("Edge %d breaks DFS order %v", edge.Key, dfs)
+		}
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	gr := buildGraph(t, true, true, 4,
+		[3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 2, 0})
+
+	_, err := algo.TopologicalSort(gr)
+	var notDAG *graph.GraphNotDAGError
+	if !errors.As(err, &notDAG) {
+		t.Fatalf("Expected GraphNotDAGError, got %v", err)
+	}
+	if !slices.Equal(notDAG.Cycle, []graph.TKey{2, 3, 4}) {
+		t.Errorf("Unexpected cycle: %v", notDAG.Cycle)
+	}
+
+	if _, err := algo.TopologicalSort(buildGraph(t, false, false, 2)); err == nil {
+		t.Errorf("Expected error for undirected graph")
+	}
+}
+
+func TestLongestPathAndCountPaths(t *testing.T) {
+	gr := buildGraph(t, true, true, 4,
+		[3]uint64{1, 2, 1}, [3]uint64{1, 2, 5}, [3]uint64{2, 4, 1}, [3]uint64{1, 3, 2}, [3]uint64{3, 4, 2})
+
+	path, length, err := algo.LongestPathInDAG(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if length != 6 || !slices.Equal(path, []graph.TKey{1, 2, 4}) {
+		t.Errorf("Unexpected longest path %v with length %d", path, length)
+	}
+
+	count, err := algo.CountPathsInDAG(gr, 1, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if count.Int64() != 3 {
+		t.Errorf("Expected 3 paths, got %v", count)
+	}
+}
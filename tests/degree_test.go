@@ -0,0 +1,78 @@
+package graph_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestDegreeStatistics(t *testing.T) {
+	// 1 -> 2, 1 -> 3, 1 -> 4, 2 -> 3
+	gr := buildGraph(t, true, false, 4, [3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0}, [3]uint64{2, 3, 0})
+
+	out := algo.DegreeStatistics(gr, algo.DegreeOut)
+	if out.Min != 0 || out.Max != 3 || out.Mean != 1 || out.Median != 0.5 || out.Distribution[0] != 2 {
+		t.Errorf("Unexpected out-degree stats %+v", out)
+	}
+	total := algo.DegreeStatistics(gr, algo.DegreeTotal)
+	if total.Min != 1 || total.Max != 3 || total.Mean != 2 || total.Median != 2 {
+		t.Errorf("Unexpected total degree stats %+v", total)
+	}
+
+	histogram := algo.DegreeHistogram(gr, algo.DegreeTotal, 2)
+	if len(histogram) != 2 || histogram[0].From != 1 || histogram[0].To != 2 || histogram[0].Count != 3 || histogram[1].Count != 1 {
+		t.Errorf("Unexpected histogram %+v", histogram)
+	}
+}
+
+func TestDegreeAssortativity(t *testing.T) {
+	// Star: hub only links to leaves, so assortativity is -1
+	star := buildGraph(t, false, false, 4, [3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0})
+	if r, ok := algo.DegreeAssortativity(star); !ok || math.Abs(r+1) > 1e-9 {
+		t.Errorf("Expected assortativity -1 for star, got %f (%v)", r, ok)
+	}
+
+	cycle := buildGraph(t, false, false, 3, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 1, 0})
+	if _, ok := algo.DegreeAssortativity(cycle); ok {
+		t.Error("Assortativity of regular graph should be undefined")
+	}
+}
+
+func TestDegreeSequences(t *testing.T) {
+	cases := []struct {
+		sequence  []int
+		graphical bool
+	}{
+		{[]int{3, 3, 2, 2, 2}, true},
+		{[]int{3, 3, 3, 3}, true},
+		{[]int{3, 3, 1, 1}, false},
+		{[]int{2, 2, 1}, false},
+		{[]int{4, 1, 1, 1}, false},
+		{[]int{}, true},
+	}
+
+	for _, c := range cases {
+		if algo.IsGraphical(c.sequence) != c.graphical {
+			t.Errorf("Expected graphical %v for %v", c.graphical, c.sequence)
+		}
+
+		gr, err := algo.HavelHakimi(c.sequence)
+		if !c.graphical {
+			if err == nil {
+				t.Errorf("Expected error for %v", c.sequence)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed to build graph for %v: %v", c.sequence, err)
+		}
+		degrees := algo.Degrees(gr, algo.DegreeTotal)
+		for i, d := range c.sequence {
+			if degrees[graph.TKey(i+1)] != d {
+				t.Errorf("Node %d should have degree %d, got %d", i+1, d, degrees[graph.TKey(i+1)])
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/generate"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestTransposeAndLineGraph(t *testing.T) {
+	gr := buildGraph(t, true, false, 3, [3]uint64{1, 2, 4}, [3]uint64{2, 3, 5})
+
+	transposed, err := algo.Transpose(gr)
+	if err != nil {
+		t.Fatalf("Transpose failed: %v", err)
+	}
+	if edge := transposed.Edges[1]; edge.Source != 2 || edge.Destination != 1 || edge.Weight != 4 {
+		t.Errorf("Edge 1 should become 2 -> 1 with weight 4, got %+v", edge)
+	}
+	if gr.Edges[1].Source != 1 {
+		t.Error("Transpose changed original graph")
+	}
+	if _, err := algo.Transpose(buildGraph(t, false, false, 1)); err == nil {
+		t.Error("Expected error for undirected graph")
+	}
+
+	// Line digraph of path 1 -> 2 -> 3 is a single arc 1 -> 2
+	line := algo.LineGraph(gr)
+	if len(line.Nodes) != 2 || len(line.Edges) != 1 || line.Edges[1].Source != 1 || line.Edges[1].Destination != 2 {
+		t.Errorf("Unexpected line digraph %v", line.Edges)
+	}
+
+	// Line graph of star with three leaves is a triangle
+	star := buildGraph(t, false, false, 4, [3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0})
+	line = algo.LineGraph(star)
+	if len(line.Nodes) != 3 || len(line.Edges) != 3 || algo.TriangleCount(line) != 1 {
+		t.Errorf("Line graph of star should be a triangle, got %d edges", len(line.Edges))
+	}
+	if line.Nodes[1].Label != "1-2" {
+		t.Errorf("Unexpected line graph node label %q", line.Nodes[1].Label)
+	}
+}
+
+func TestPower(t *testing.T) {
+	// Path 1-2-3-4-5
+	gr := buildGraph(t, false, false, 5,
+		[3]uint64{1, 2, 1}, [3]uint64{2, 3, 1}, [3]uint64{3, 4, 1}, [3]uint64{4, 5, 1})
+
+	square, err := algo.Square(gr)
+	if err != nil || len(square.Edges) != 7 {
+		t.Errorf("Square of P5 should have 7 edges, got %d", len(square.Edges))
+	}
+	if power, _ := algo.Power(gr, 4); len(power.Edges) != 10 {
+		t.Error("P5 to the 4th power should be complete")
+	}
+	if power, _ := algo.Power(gr, 1); len(power.Edges) != 4 {
+		t.Error("First power should be a copy")
+	}
+	// Power of a long path is almost complete, with about 3 million edges
+	if _, err := algo.Power(generate.Path(2500), 2500); err == nil {
+		t.Error("Expected error for too big power")
+	}
+
+	directed := buildGraph(t, true, false, 3, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0})
+	square, _ = algo.Square(directed)
+	if len(square.Edges) != 3 || square.Edges[3].Source != 1 || square.Edges[3].Destination != 3 || square.Edges[3].Weight != 2 {
+		t.Errorf("Unexpected square of directed path %v", square.Edges)
+	}
+}
+
+func TestTransitiveClosureAndReduction(t *testing.T) {
+	// 1 -> 2 -> 3 -> 4, with shortcuts 1 -> 3, 1 -> 4 and parallel 2 -> 3
+	gr := buildGraph(t, true, true, 4,
+		[3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 4, 0},
+		[3]uint64{1, 3, 0}, [3]uint64{1, 4, 0}, [3]uint64{2, 3, 0})
+
+	reduction, err := algo.TransitiveReduction(gr)
+	if err != nil {
+		t.Fatalf("Transitive reduction failed: %v", err)
+	}
+	if len(reduction.Edges) != 3 || reduction.Edges[1] == nil || reduction.Edges[2] == nil || reduction.Edges[3] == nil {
+		t.Errorf("Reduction should keep only the path, got %v", reduction.Edges)
+	}
+
+	closure, err := algo.TransitiveClosure(reduction)
+	if err != nil || len(closure.Edges) != 6 {
+		t.Errorf("Closure of path on 4 nodes should have 6 arcs, got %d", len(closure.Edges))
+	}
+
+	cyclic := buildGraph(t, true, false, 2, [3]uint64{1, 2, 0}, [3]uint64{2, 1, 0})
+	if _, err := algo.TransitiveReduction(cyclic); err == nil {
+		t.Error("Expected error for graph with cycle")
+	}
+
+	// Undirected closure makes components complete
+	undirected := buildGraph(t, false, false, 5, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{4, 5, 0})
+	if closure, _ := algo.TransitiveClosure(undirected); len(closure.Edges) != 4 {
+		t.Errorf("Expected triangle and an edge, got %d edges", len(closure.Edges))
+	}
+
+	// Closure of a long directed path has 2 million arcs
+	if _, err := algo.TransitiveClosure(generate.Path(2001, generate.WithDirected(true))); err == nil {
+		t.Error("Expected error for too big closure")
+	}
+	if _, err := algo.TransitiveClosure(generate.Path(algo.TransitiveClosureNodesLimit + 1)); err == nil {
+		t.Error("Expected error for too many nodes")
+	}
+}
+
+func TestInducedSubgraphs(t *testing.T) {
+	gr := buildGraph(t, false, false, 4,
+		[3]uint64{1, 2, 3}, [3]uint64{2, 3, 0}, [3]uint64{3, 1, 0}, [3]uint64{3, 4, 0})
+
+	induced, err := algo.InducedSubgraph(gr, []graph.TKey{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Induced subgraph failed: %v", err)
+	}
+	if len(induced.Nodes) != 3 || len(induced.Edges) != 3 || induced.Edges[1].Weight != 3 {
+		t.Errorf("Unexpected induced subgraph %v", induced.Edges)
+	}
+
+	edgeInduced, err := algo.EdgeInducedSubgraph(gr, []graph.TKey{4})
+	if err != nil {
+		t.Fatalf("Edge-induced subgraph failed: %v", err)
+	}
+	if len(edgeInduced.Nodes) != 2 || len(edgeInduced.Edges) != 1 || edgeInduced.Nodes[4] == nil {
+		t.Errorf("Unexpected edge-induced subgraph %v", edgeInduced.Nodes)
+	}
+
+	if _, err := algo.InducedSubgraph(gr, []graph.TKey{9}); err == nil {
+		t.Error("Expected error for missing node")
+	}
+	if _, err := algo.EdgeInducedSubgraph(gr, []graph.TKey{9}); err == nil {
+		t.Error("Expected error for missing edge")
+	}
+}
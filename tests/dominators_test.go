@@ -0,0 +1,131 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/generate"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+// 1 -> 2, 2 -> 3 | 4 -> 5, loop 5 -> 2, exit 5 -> 6
+func buildCFG(t *testing.T) *graph.Graph {
+	return buildGraph(t, true, false, 6,
+		[3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{2, 4, 0},
+		[3]uint64{3, 5, 0}, [3]uint64{4, 5, 0}, [3]uint64{5, 2, 0}, [3]uint64{5, 6, 0})
+}
+
+func TestDominators(t *testing.T) {
+	idom, err := algo.Dominators(buildCFG(t), 1)
+	if err != nil {
+		t.Fatalf("Dominators failed: %v", err)
+	}
+	expected := map[graph.TKey]graph.TKey{2: 1, 3: 2, 4: 2, 5: 2, 6: 5}
+	if !reflect.DeepEqual(idom, expected) {
+		t.Errorf("Expected %v, got %v", expected, idom)
+	}
+
+	// Example from the paper by Lengauer and Tarjan, R = 1, A = 2, ..., L = 13
+	paper := buildGraph(t, true, false, 13,
+		[3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0}, [3]uint64{2, 5, 0},
+		[3]uint64{3, 2, 0}, [3]uint64{3, 5, 0}, [3]uint64{3, 6, 0}, [3]uint64{4, 7, 0},
+		[3]uint64{4, 8, 0}, [3]uint64{5, 13, 0}, [3]uint64{6, 9, 0}, [3]uint64{7, 10, 0},
+		[3]uint64{8, 10, 0}, [3]uint64{8, 11, 0}, [3]uint64{9, 6, 0}, [3]uint64{9, 12, 0},
+		[3]uint64{10, 12, 0}, [3]uint64{11, 10, 0}, [3]uint64{12, 10, 0}, [3]uint64{12, 1, 0},
+		[3]uint64{13, 9, 0})
+	idom, _ = algo.Dominators(paper, 1)
+	expected = map[graph.TKey]graph.TKey{
+		2: 1, 3: 1, 4: 1, 5: 1, 6: 1, 7: 4, 8: 4, 9: 1, 10: 1, 11: 8, 12: 1, 13: 5,
+	}
+	if !reflect.DeepEqual(idom, expected) {
+		t.Errorf("Expected %v, got %v", expected, idom)
+	}
+
+	postdom, err := algo.PostDominators(buildCFG(t), 6)
+	if err != nil {
+		t.Fatalf("Post-dominators failed: %v", err)
+	}
+	expected = map[graph.TKey]graph.TKey{1: 2, 2: 5, 3: 5, 4: 5, 5: 6}
+	if !reflect.DeepEqual(postdom, expected) {
+		t.Errorf("Expected post-dominators %v, got %v", expected, postdom)
+	}
+
+	if _, err := algo.Dominators(buildGraph(t, false, false, 2), 1); err == nil {
+		t.Error("Expected error for undirected graph")
+	}
+	if _, err := algo.Dominators(buildCFG(t), 42); err == nil {
+		t.Error("Expected error for missing entry")
+	}
+}
+
+// d dominates v if v cannot be reached from entry once d is removed
+func bruteForceDominates(gr *graph.Graph, entry, d, v graph.TKey) bool {
+	if d == entry || d == v {
+		return true
+	}
+	without := gr.Copy()
+	without.RemoveNodeByKey(d)
+	visited := map[graph.TKey]bool{entry: true}
+	stack := []graph.TKey{entry}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, w := range without.AdjacencyMap[u] {
+			if !visited[w] {
+				visited[w] = true
+				stack = append(stack, w)
+			}
+		}
+	}
+	return !visited[v]
+}
+
+func TestDominatorsRandom(t *testing.T) {
+	for seed := range uint64(20) {
+		gr, _ := generate.ErdosRenyi(15, 0.15, generate.WithDirected(true), generate.WithSeed(seed))
+		tree, err := algo.DominatorTree(gr, 1, false)
+		if err != nil {
+			t.Fatalf("Dominator tree failed: %v", err)
+		}
+		for _, v := range tree.Order {
+			// Dominators of v are exactly its ancestors in dominator tree
+			ancestors := map[graph.TKey]bool{v: true}
+			for u := v; u != tree.Root; u = tree.Parent[u] {
+				ancestors[tree.Parent[u]] = true
+			}
+			for _, d := range tree.Order {
+				if ancestors[d] != bruteForceDominates(gr, 1, d, v) {
+					t.Fatalf("Seed %d: wrong domination of %d by %d", seed, v, d)
+				}
+			}
+		}
+	}
+}
+
+func TestDominanceFrontiersAndLoops(t *testing.T) {
+	frontiers, err := algo.DominanceFrontiers(buildCFG(t), 1)
+	if err != nil {
+		t.Fatalf("Dominance frontiers failed: %v", err)
+	}
+	expected := map[graph.TKey][]graph.TKey{1: {}, 2: {2}, 3: {5}, 4: {5}, 5: {2}, 6: {}}
+	if !reflect.DeepEqual(frontiers, expected) {
+		t.Errorf("Expected %v, got %v", expected, frontiers)
+	}
+
+	loops, err := algo.NaturalLoops(buildCFG(t), 1)
+	if err != nil {
+		t.Fatalf("Natural loops failed: %v", err)
+	}
+	if len(loops) != 1 || loops[0].Header != 2 || !reflect.DeepEqual(loops[0].Nodes, []graph.TKey{2, 3, 4, 5}) ||
+		!reflect.DeepEqual(loops[0].BackEdges, []graph.TKey{6}) {
+		t.Errorf("Unexpected loops %+v", loops)
+	}
+
+	// Irreducible cycle 2 <-> 3 entered from both sides has no natural loop
+	irreducible := buildGraph(t, true, false, 3,
+		[3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 2, 0})
+	if loops, _ := algo.NaturalLoops(irreducible, 1); len(loops) != 0 {
+		t.Errorf("Expected no natural loops, got %+v", loops)
+	}
+}
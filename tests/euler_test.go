@@ -0,0 +1,88 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+// Checks that route uses every edge exactly once and consecutive edges touch
+func checkEulerianRoute(t *testing.T, gr *graph.Graph, route []graph.TKey) {
+	t.Helper()
+	if len(route) != len(gr.Edges) {
+		t.Fatalf("Route %v must contain %d edges", route, len(gr.Edges))
+	}
+	used := make(map[graph.TKey]bool)
+	var at graph.TKey
+	for i, key := range route {
+		if used[key] {
+			t.Fatalf("Edge %d used twice in %v", key, route)
+		}
+		used[key] = true
+		edge := gr.Edges[key]
+		switch {
+		case i == 0:
+			at = edge.Destination
+			if !gr.Options.IsDirected && len(route) > 1 {
+				next := gr.Edges[route[1]]
+				if edge.Source == next.Source || edge.Source == next.Destination {
+					at = edge.Source
+				}
+			}
+		case edge.Source == at:
+			at = edge.Destination
+		case !gr.Options.IsDirected && edge.Destination == at:
+			at = edge.Source
+		default:
+			t.Fatalf("Edge %d does not continue route %v", key, route)
+		}
+	}
+}
+
+func TestEulerianDirectedMultigraph(t *testing.T) {
+	gr := buildGraph(t, true, true, 3,
+		[3]uint64{1, 2, 0}, [3]uint64{2, 1, 0}, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 1, 0}, [3]uint64{1, 3, 0})
+
+	if algo.HasEulerianCircuit(gr) {
+		t.Errorf("Graph must have no Eulerian circuit")
+	}
+	path, err := algo.EulerianPath(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	checkEulerianRoute(t, gr, path)
+	if gr.Edges[path[0]].Source != 1 {
+		t.Errorf("Path must start in node 1, got %v", path)
+	}
+}
+
+func TestEulerianUndirectedCircuit(t *testing.T) {
+	gr := buildGraph(t, false, true, 4,
+		[3]uint64{1, 2, 0}, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 2, 0}, [3]uint64{3, 3, 0})
+
+	circuit, err := algo.EulerianCircuit(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	checkEulerianRoute(t, gr, circuit)
+
+	disconnected := buildGraph(t, false, false, 4, [3]uint64{1, 2, 0}, [3]uint64{3, 4, 0})
+	if algo.HasEulerianPath(disconnected) {
+		t.Errorf("Disconnected graph must have no Eulerian path")
+	}
+}
+
+func TestChinesePostman(t *testing.T) {
+	// Square 1-2-3-4 with diagonal 1-3: nodes 1 and 3 are odd
+	gr := buildGraph(t, false, false, 4,
+		[3]uint64{1, 2, 1}, [3]uint64{2, 3, 1}, [3]uint64{3, 4, 1}, [3]uint64{4, 1, 1}, [3]uint64{1, 3, 5})
+
+	route, err := algo.ChinesePostman(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if route.Cost != 11 || len(route.Edges) != 7 || len(route.Duplicated) != 2 {
+		t.Errorf("Expected route of 7 edges with cost 11, got %v with cost %d", route.Edges, route.Cost)
+	}
+}
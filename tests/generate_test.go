@@ -0,0 +1,126 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/generate"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func checkRegular(t *testing.T, name string, gr *graph.Graph, d int) {
+	t.Helper()
+	for key, degree := range algo.Degrees(gr, algo.DegreeTotal) {
+		if degree != d {
+			t.Errorf("%s: node %d has degree %d, expected %d", name, key, degree, d)
+		}
+	}
+}
+
+func TestClassicGenerators(t *testing.T) {
+	tests := []struct {
+		name  string
+		gr    *graph.Graph
+		nodes int
+		edges int
+	}{
+		{"Complete", generate.Complete(5), 5, 10},
+		{"Directed complete", generate.Complete(4, generate.WithDirected(true)), 4, 12},
+		{"Path", generate.Path(5), 5, 4},
+		{"Cycle", generate.Cycle(5), 5, 5},
+		{"Star", generate.Star(5), 5, 4},
+		{"Wheel", generate.Wheel(6), 6, 10},
+		{"Grid", generate.Grid(3, 4), 12, 17},
+		{"Petersen", generate.Petersen(), 10, 15},
+	}
+	for _, test := range tests {
+		if len(test.gr.Nodes) != test.nodes || len(test.gr.Edges) != test.edges {
+			t.Errorf("%s: expected %d nodes and %d edges, got %d and %d",
+				test.name, test.nodes, test.edges, len(test.gr.Nodes), len(test.gr.Edges))
+		}
+	}
+
+	petersen := generate.Petersen()
+	checkRegular(t, "Petersen", petersen, 3)
+	if girth, _ := algo.Girth(petersen); girth != 5 {
+		t.Errorf("Petersen graph should have girth 5, got %d", girth)
+	}
+
+	cube, err := generate.Hypercube(3)
+	if err != nil {
+		t.Fatalf("Hypercube failed: %v", err)
+	}
+	checkRegular(t, "Hypercube", cube, 3)
+	if len(cube.Nodes) != 8 || cube.Nodes[6].Label != "101" {
+		t.Errorf("Unexpected hypercube with %d nodes", len(cube.Nodes))
+	}
+	if _, err := generate.Hypercube(-1); err == nil {
+		t.Error("Expected error for negative dimension")
+	}
+
+	weighted := generate.Cycle(10, generate.WithWeights(3, 5), generate.WithSeed(1))
+	for _, edge := range weighted.Edges {
+		if edge.Weight < 3 || edge.Weight > 5 {
+			t.Errorf("Weight %d is out of range", edge.Weight)
+		}
+	}
+}
+
+func TestRandomGenerators(t *testing.T) {
+	gnp, err := generate.ErdosRenyi(50, 0.2, generate.WithSeed(7))
+	if err != nil {
+		t.Fatalf("G(n, p) failed: %v", err)
+	}
+	again, _ := generate.ErdosRenyi(50, 0.2, generate.WithSeed(7))
+	if !reflect.DeepEqual(gnp.Edges, again.Edges) {
+		t.Error("Same seed should give the same graph")
+	}
+	if _, err := generate.ErdosRenyi(5, 1.5); err == nil {
+		t.Error("Expected error for probability above 1")
+	}
+
+	gnm, err := generate.ErdosRenyiM(10, 45, generate.WithSeed(3))
+	if err != nil || len(gnm.Edges) != 45 {
+		t.Errorf("G(10, 45) should be complete, got %d edges, error %v", len(gnm.Edges), err)
+	}
+	if _, err := generate.ErdosRenyiM(10, 46); err == nil {
+		t.Error("Expected error for too many edges")
+	}
+	if _, err := generate.ErdosRenyiM(-5, 3); err == nil {
+		t.Error("Expected error for negative n")
+	}
+
+	ba, err := generate.BarabasiAlbert(100, 3, generate.WithSeed(5))
+	if err != nil || len(ba.Edges) != 97*3 || !algo.IsConnected(ba) {
+		t.Errorf("Unexpected Barabási–Albert graph with %d edges, error %v", len(ba.Edges), err)
+	}
+
+	ws, err := generate.WattsStrogatz(30, 4, 0.3, generate.WithSeed(2))
+	if err != nil || len(ws.Edges) != 60 {
+		t.Errorf("Unexpected Watts–Strogatz graph with %d edges, error %v", len(ws.Edges), err)
+	}
+	lattice, _ := generate.WattsStrogatz(30, 4, 0)
+	checkRegular(t, "Ring lattice", lattice, 4)
+	if _, err := generate.WattsStrogatz(10, 3, 0.1); err == nil {
+		t.Error("Expected error for odd k")
+	}
+
+	for seed := range uint64(5) {
+		regular, err := generate.RandomRegular(20, 3, generate.WithSeed(seed))
+		if err != nil {
+			t.Fatalf("Random regular graph failed: %v", err)
+		}
+		checkRegular(t, "Random regular", regular, 3)
+	}
+	if _, err := generate.RandomRegular(5, 3); err == nil {
+		t.Error("Expected error for odd n * d")
+	}
+
+	for seed := range uint64(5) {
+		tree := generate.RandomTree(30, generate.WithSeed(seed))
+		if len(tree.Edges) != 29 || !algo.IsConnected(tree) {
+			t.Errorf("Random tree should be connected with 29 edges, got %d", len(tree.Edges))
+		}
+	}
+}
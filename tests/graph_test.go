@@ -127,3 +127,13 @@ func TestAddEdgeNodesMustExist(t *testing.T) {
 		t.Errorf("Expected error when adding edge with non-existing node")
 	}
 }
+
+func TestNodeAttributesAreCopied(t *testing.T) {
+	gr := graph.MakeGraph()
+	gr.AddNode(graph.MakeNode(1, graph.WithNodeAttribute("color", "2")))
+	copied := gr.Copy()
+	copied.Nodes[1].UpdateNode(graph.WithNodeAttribute("color", "3"))
+	if gr.Nodes[1].Attributes["color"] != "2" || copied.Nodes[1].Attributes["color"] != "3" {
+		t.Errorf("Attributes must be copied, not shared")
+	}
+}
@@ -0,0 +1,26 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+// Builds graph with nodes 1..n and edges given as {src, dst, weight} triples.
+// Edge keys are assigned from 1 in order of appearance
+func buildGraph(t *testing.T, directed, multi bool, n int, edges ...[3]uint64) *graph.Graph {
+	t.Helper()
+	gr := graph.MakeGraph(graph.WithGraphDirected(directed), graph.WithGraphMulti(multi))
+	for i := 1; i <= n; i++ {
+		if err := gr.AddNode(graph.MakeNode(graph.TKey(i))); err != nil {
+			t.Fatalf("Failed to add node: %v", err)
+		}
+	}
+	for i, e := range edges {
+		edge := graph.MakeEdge(graph.TKey(i+1), graph.TKey(e[0]), graph.TKey(e[1]), graph.WithEdgeWeight(graph.TWeight(e[2])))
+		if err := gr.AddEdge(edge); err != nil {
+			t.Fatalf("Failed to add edge: %v", err)
+		}
+	}
+	return gr
+}
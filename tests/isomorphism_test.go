@@ -0,0 +1,101 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+// Checks that mapping keeps every edge of pattern
+func checkMapping(t *testing.T, gr, pattern *graph.Graph, mapping map[graph.TKey]graph.TKey) {
+	t.Helper()
+	for _, edge := range pattern.Edges {
+		src, dst := mapping[edge.Source], mapping[edge.Destination]
+		found := false
+		for _, other := range gr.Edges {
+			if other.Source == src && other.Destination == dst || !gr.Options.IsDirected && other.Source == dst && other.Destination == src {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Edge %d-%d is mapped to missing edge %d-%d", edge.Source, edge.Destination, src, dst)
+		}
+	}
+}
+
+func TestIsomorphism(t *testing.T) {
+	cycle := buildGraph(t, false, false, 6, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 5, 0}, [3]uint64{5, 6, 0}, [3]uint64{6, 1, 0})
+	shuffled := buildGraph(t, false, false, 6, [3]uint64{1, 4, 0}, [3]uint64{4, 2, 0}, [3]uint64{2, 6, 0}, [3]uint64{6, 3, 0}, [3]uint64{3, 5, 0}, [3]uint64{5, 1, 0})
+	triangles := buildGraph(t, false, false, 6, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 1, 0}, [3]uint64{4, 5, 0}, [3]uint64{5, 6, 0}, [3]uint64{6, 4, 0})
+
+	mapping, ok := algo.Isomorphism(cycle, shuffled)
+	if !ok {
+		t.Fatal("Relabelled cycle should be isomorphic")
+	}
+	checkMapping(t, shuffled, cycle, mapping)
+	if algo.CanonicalHash(cycle) != algo.CanonicalHash(shuffled) {
+		t.Error("Isomorphic graphs should have equal hashes")
+	}
+
+	// Both are 2-regular, so only the search tells them apart
+	if _, ok := algo.Isomorphism(cycle, triangles); ok {
+		t.Error("C6 and two triangles are not isomorphic")
+	}
+}
+
+func TestDirectedAndMultiIsomorphism(t *testing.T) {
+	chain := buildGraph(t, true, true, 3, [3]uint64{1, 2, 0}, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0})
+	same := buildGraph(t, true, true, 3, [3]uint64{3, 1, 0}, [3]uint64{2, 3, 0}, [3]uint64{2, 3, 0})
+	other := buildGraph(t, true, true, 3, [3]uint64{3, 1, 0}, [3]uint64{3, 1, 0}, [3]uint64{2, 3, 0})
+
+	mapping, ok := algo.Isomorphism(chain, same)
+	if !ok || mapping[1] != 2 || mapping[2] != 3 || mapping[3] != 1 {
+		t.Errorf("Unexpected mapping %v", mapping)
+	}
+	if _, ok := algo.Isomorphism(chain, other); ok {
+		t.Error("Parallel edges are on the other arc, graphs are not isomorphic")
+	}
+	if algo.CanonicalHash(chain) == algo.CanonicalHash(other) {
+		t.Error("Hashes should differ")
+	}
+}
+
+func TestSubgraphIsomorphism(t *testing.T) {
+	k4 := buildGraph(t, false, false, 4, [3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0}, [3]uint64{2, 3, 0}, [3]uint64{2, 4, 0}, [3]uint64{3, 4, 0})
+	triangle := buildGraph(t, false, false, 3, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 1, 0})
+	path := buildGraph(t, false, false, 3, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0})
+
+	mapping, ok := algo.SubgraphIsomorphism(k4, triangle)
+	if !ok {
+		t.Fatal("K4 contains triangle")
+	}
+	checkMapping(t, k4, triangle, mapping)
+	if all := algo.AllSubgraphIsomorphisms(k4, triangle, 0); len(all) != 24 {
+		t.Errorf("Expected 24 triangle occurrences in K4, got %d", len(all))
+	}
+
+	if _, ok := algo.SubgraphIsomorphism(k4, path); !ok {
+		t.Error("K4 contains path as a subgraph")
+	}
+	if _, ok := algo.SubgraphIsomorphism(k4, path, algo.WithInduced(true)); ok {
+		t.Error("K4 has no induced path of length 2")
+	}
+}
+
+func TestIsomorphismPredicates(t *testing.T) {
+	gr := buildGraph(t, false, false, 3, [3]uint64{1, 2, 5}, [3]uint64{2, 3, 7})
+	pattern := buildGraph(t, false, false, 2, [3]uint64{1, 2, 7})
+
+	mapping, ok := algo.SubgraphIsomorphism(gr, pattern, algo.WithEdgeMatch(algo.MatchEdgeWeights))
+	if !ok || !(mapping[1] == 2 && mapping[2] == 3 || mapping[1] == 3 && mapping[2] == 2) {
+		t.Errorf("Pattern should match edge 2-3 of weight 7, got %v", mapping)
+	}
+
+	gr.Nodes[3].UpdateNode(graph.WithNodeLabel("x"))
+	pattern.Nodes[1].UpdateNode(graph.WithNodeLabel("x"))
+	mapping, ok = algo.SubgraphIsomorphism(gr, pattern, algo.WithNodeMatch(algo.MatchNodeLabels))
+	if !ok || mapping[1] != 3 || mapping[2] != 2 {
+		t.Errorf("Labelled node should go to node 3, got %v", mapping)
+	}
+}
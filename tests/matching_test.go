@@ -0,0 +1,82 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func checkMatching(t *testing.T, gr *graph.Graph, matching []graph.TKey, size int) {
+	t.Helper()
+	if len(matching) != size {
+		t.Errorf("Expected matching of size %d, got %v", size, matching)
+	}
+	used := make(map[graph.TKey]bool)
+	for _, key := range matching {
+		edge := gr.Edges[key]
+		if used[edge.Source] || used[edge.Destination] {
+			t.Errorf("Node is matched twice in %v", matching)
+		}
+		used[edge.Source], used[edge.Destination] = true, true
+	}
+}
+
+func TestBipartiteColoring(t *testing.T) {
+	even := buildGraph(t, false, false, 4, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 1, 0})
+	color, cycle := algo.BipartiteColoring(even)
+	if color == nil || cycle != nil {
+		t.Fatalf("Expected 4-cycle to be bipartite")
+	}
+	for _, edge := range even.Edges {
+		if color[edge.Source] == color[edge.Destination] {
+			t.Errorf("Edge %d connects nodes of the same colour", edge.Key)
+		}
+	}
+
+	odd := buildGraph(t, false, false, 5,
+		[3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 5, 0}, [3]uint64{5, 1, 0})
+	color, cycle = algo.BipartiteColoring(odd)
+	if color != nil || len(cycle) != 5 {
+		t.Fatalf("Expected odd cycle of length 5, got %v", cycle)
+	}
+	for i := range cycle {
+		a, b := odd.Edges[cycle[i]], odd.Edges[cycle[(i+1)%len(cycle)]]
+		if a.Source != b.Source && a.Source != b.Destination && a.Destination != b.Source && a.Destination != b.Destination {
+			t.Errorf("Edges %d and %d of the cycle are not adjacent", a.Key, b.Key)
+		}
+	}
+}
+
+func TestHopcroftKarp(t *testing.T) {
+	gr := buildGraph(t, false, true, 8,
+		[3]uint64{1, 5, 0}, [3]uint64{1, 6, 0}, [3]uint64{2, 5, 0}, [3]uint64{3, 6, 0},
+		[3]uint64{3, 7, 0}, [3]uint64{4, 7, 0}, [3]uint64{4, 8, 0}, [3]uint64{4, 8, 0})
+
+	matching, err := algo.HopcroftKarp(gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	checkMatching(t, gr, matching, 4)
+
+	triangle := buildGraph(t, false, false, 3, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 1, 0})
+	if _, err := algo.HopcroftKarp(triangle); err == nil {
+		t.Errorf("Expected error for non-bipartite graph")
+	}
+}
+
+func TestBlossomMatching(t *testing.T) {
+	// Two triangles joined by an edge and pendant nodes: perfect matching needs blossom
+	gr := buildGraph(t, false, false, 8,
+		[3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 1, 0},
+		[3]uint64{4, 5, 0}, [3]uint64{5, 6, 0}, [3]uint64{6, 4, 0},
+		[3]uint64{3, 4, 0}, [3]uint64{1, 7, 0}, [3]uint64{6, 8, 0})
+
+	checkMatching(t, gr, algo.BlossomMatching(gr), 4)
+
+	petersen := buildGraph(t, false, false, 10,
+		[3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 5, 0}, [3]uint64{5, 1, 0},
+		[3]uint64{1, 6, 0}, [3]uint64{2, 7, 0}, [3]uint64{3, 8, 0}, [3]uint64{4, 9, 0}, [3]uint64{5, 10, 0},
+		[3]uint64{6, 8, 0}, [3]uint64{8, 10, 0}, [3]uint64{10, 7, 0}, [3]uint64{7, 9, 0}, [3]uint64{9, 6, 0})
+	checkMatching(t, petersen, algo.BlossomMatching(petersen), 5)
+}
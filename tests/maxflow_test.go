@@ -0,0 +1,81 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+var maxFlowSolvers = map[string]func(*graph.Graph, graph.TKey, graph.TKey) (*algo.MaxFlowResult, error){
+	"EdmondsKarp": algo.MaxFlowEdmondsKarp,
+	"Dinic":       algo.MaxFlowDinic,
+	"PushRelabel": algo.MaxFlowPushRelabel,
+}
+
+func checkFlowConservation(t *testing.T, gr *graph.Graph, res *algo.MaxFlowResult, src, dst graph.TKey) {
+	t.Helper()
+	balance := make(map[graph.TKey]int64)
+	for key, flow := range res.Flow {
+		edge := gr.Edges[key]
+		if flow > edge.Weight {
+			t.Errorf("Flow %d on edge %d exceeds capacity %d", flow, key, edge.Weight)
+		}
+		balance[edge.Source] -= int64(flow)
+		balance[edge.Destination] += int64(flow)
+	}
+	for key, value := range balance {
+		if key != src && key != dst && value != 0 {
+			t.Errorf("Flow is not conserved in node %d", key)
+		}
+	}
+	if balance[dst] != int64(res.Value) {
+		t.Errorf("Sink receives %d, but flow value is %d", balance[dst], res.Value)
+	}
+}
+
+func TestMaxFlow(t *testing.T) {
+	// Classic CLRS network with antiparallel edges 2->3 and 3->2
+	gr := buildGraph(t, true, false, 6,
+		[3]uint64{1, 2, 16}, [3]uint64{1, 3, 13}, [3]uint64{2, 3, 10}, [3]uint64{3, 2, 4},
+		[3]uint64{2, 4, 12}, [3]uint64{4, 3, 9}, [3]uint64{3, 5, 14}, [3]uint64{5, 4, 7},
+		[3]uint64{4, 6, 20}, [3]uint64{5, 6, 4})
+
+	for name, solve := range maxFlowSolvers {
+		res, err := solve(gr, 1, 6)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if res.Value != 23 {
+			t.Errorf("%s: expected flow 23, got %d", name, res.Value)
+		}
+		checkFlowConservation(t, gr, res, 1, 6)
+		if !slices.Contains(res.SourceSide, 1) || !slices.Contains(res.SinkSide, 6) {
+			t.Errorf("%s: wrong cut partition", name)
+		}
+	}
+}
+
+func TestMaxFlowParallelEdges(t *testing.T) {
+	gr := buildGraph(t, true, true, 3,
+		[3]uint64{1, 2, 3}, [3]uint64{1, 2, 4}, [3]uint64{2, 3, 5}, [3]uint64{2, 3, 1}, [3]uint64{2, 1, 9})
+
+	for name, solve := range maxFlowSolvers {
+		res, err := solve(gr, 1, 3)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if res.Value != 6 {
+			t.Errorf("%s: expected flow 6, got %d", name, res.Value)
+		}
+		checkFlowConservation(t, gr, res, 1, 3)
+		if !slices.Equal(res.CutEdges, []graph.TKey{3, 4}) {
+			t.Errorf("%s: unexpected cut edges %v", name, res.CutEdges)
+		}
+	}
+
+	if _, err := algo.MaxFlowDinic(gr, 1, 1); err == nil {
+		t.Errorf("Expected error when source is sink")
+	}
+}
@@ -0,0 +1,73 @@
+package graph_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestDistanceMetrics(t *testing.T) {
+	// Path 1-2-3-4-5, edge 4-5 is heavy
+	gr := buildGraph(t, false, false, 5, [3]uint64{1, 2, 1}, [3]uint64{2, 3, 1}, [3]uint64{3, 4, 1}, [3]uint64{4, 5, 10})
+
+	stats, err := algo.DistanceMetrics(gr, false)
+	if err != nil {
+		t.Fatalf("Failed to compute metrics: %v", err)
+	}
+	if stats.Diameter != 4 || stats.Radius != 2 || stats.Eccentricity[2] != 3 {
+		t.Errorf("Unexpected hop metrics %+v", stats)
+	}
+	if !slices.Equal(stats.Center, []graph.TKey{3}) || !slices.Equal(stats.Periphery, []graph.TKey{1, 5}) {
+		t.Errorf("Unexpected center %v or periphery %v", stats.Center, stats.Periphery)
+	}
+
+	stats, err = algo.DistanceMetrics(gr, true)
+	if err != nil {
+		t.Fatalf("Failed to compute metrics: %v", err)
+	}
+	if stats.Diameter != 13 || stats.Radius != 10 || !slices.Equal(stats.Center, []graph.TKey{4}) {
+		t.Errorf("Unexpected weighted metrics %+v", stats)
+	}
+
+	// Path 1 -> 2 -> 3 is not strongly connected
+	directed := buildGraph(t, true, false, 3, [3]uint64{1, 2, 1}, [3]uint64{2, 3, 1})
+	if _, err := algo.Diameter(directed, false); err == nil {
+		t.Error("Expected error for not strongly connected graph")
+	}
+	if avg := algo.AverageShortestPath(directed, false); math.Abs(avg-4.0/3) > 1e-9 {
+		t.Errorf("Unexpected average shortest path %f", avg)
+	}
+	if avg, stats := algo.AllPairsMetrics(directed, false); stats != nil || math.Abs(avg-4.0/3) > 1e-9 {
+		t.Errorf("Expected average without stats, got %f and %+v", avg, stats)
+	}
+
+	// Average over 20 ordered pairs of the path: 2 * (4*1 + 3*2 + 2*3 + 1*4) / 20
+	if avg, stats := algo.AllPairsMetrics(gr, false); stats == nil || stats.Diameter != 4 || math.Abs(avg-2) > 1e-9 {
+		t.Errorf("Unexpected all-pairs metrics %f and %+v", avg, stats)
+	}
+}
+
+func TestGirth(t *testing.T) {
+	cases := []struct {
+		name     string
+		gr       *graph.Graph
+		expected int
+	}{
+		{"pentagon", buildGraph(t, false, false, 5, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 5, 0}, [3]uint64{5, 1, 0}), 5},
+		{"square with tail", buildGraph(t, false, false, 6, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 1, 0}, [3]uint64{4, 5, 0}, [3]uint64{5, 6, 0}), 4},
+		{"parallel edges", buildGraph(t, false, true, 3, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{2, 3, 0}), 2},
+		{"self-loop", buildGraph(t, false, true, 2, [3]uint64{1, 2, 0}, [3]uint64{2, 2, 0}), 1},
+		{"directed triangle", buildGraph(t, true, false, 4, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 1, 0}, [3]uint64{1, 4, 0}, [3]uint64{4, 3, 0}), 3},
+		{"tree", buildGraph(t, false, false, 4, [3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0}), 0},
+	}
+
+	for _, c := range cases {
+		girth, ok := algo.Girth(c.gr)
+		if girth != c.expected || ok != (c.expected != 0) {
+			t.Errorf("%s: expected girth %d, got %d (%v)", c.name, c.expected, girth, ok)
+		}
+	}
+}
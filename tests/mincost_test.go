@@ -0,0 +1,86 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestMinCostMaxFlow(t *testing.T) {
+	gr := buildGraph(t, true, true, 4,
+		[3]uint64{1, 2, 2}, [3]uint64{1, 3, 1}, [3]uint64{2, 3, 1}, [3]uint64{2, 4, 1}, [3]uint64{3, 4, 2}, [3]uint64{2, 4, 1})
+	costs := map[graph.TKey]graph.TWeight{1: 1, 2: 2, 3: 1, 4: 3, 5: 1, 6: 7}
+	for key, cost := range costs {
+		gr.Edges[key].UpdateEdge(graph.WithEdgeCost(cost))
+	}
+
+	res, err := algo.MinCostMaxFlow(gr, 1, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Paths: 1-2-3-4 (cost 3), 1-3-4 (cost 3), 1-2-4 (cost 4)
+	if res.Value != 3 || res.Cost != 10 {
+		t.Errorf("Expected flow 3 with cost 10, got %d with cost %d", res.Value, res.Cost)
+	}
+	if res.Flow[6] != 0 {
+		t.Errorf("Expensive parallel edge must stay unused, got flow %d", res.Flow[6])
+	}
+}
+
+func TestHungarianAssignment(t *testing.T) {
+	// Workers 1..3, jobs 4..6
+	gr := buildGraph(t, false, false, 6,
+		[3]uint64{1, 4, 4}, [3]uint64{1, 5, 1}, [3]uint64{1, 6, 3},
+		[3]uint64{2, 4, 2}, [3]uint64{2, 5, 0}, [3]uint64{2, 6, 5},
+		[3]uint64{3, 4, 3}, [3]uint64{3, 5, 2}, [3]uint64{3, 6, 2})
+
+	res, err := algo.HungarianAssignment(gr, []graph.TKey{1, 2, 3}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Weight != 5 || res.Pairs[1] != 5 || res.Pairs[2] != 4 || res.Pairs[3] != 6 {
+		t.Errorf("Unexpected assignment %v with weight %d", res.Pairs, res.Weight)
+	}
+
+	res, err = algo.HungarianAssignment(gr, []graph.TKey{1, 2, 3}, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Weight != 11 {
+		t.Errorf("Expected maximum weight 11, got %d", res.Weight)
+	}
+}
+
+func TestHungarianAssignmentMissingEdges(t *testing.T) {
+	// Worker 3 can do only job 4, which is the cheapest one for everybody
+	gr := buildGraph(t, false, false, 5,
+		[3]uint64{1, 4, 1}, [3]uint64{1, 5, 9}, [3]uint64{3, 4, 5})
+
+	res, err := algo.HungarianAssignment(gr, []graph.TKey{1, 2, 3}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res.Pairs) != 2 || res.Pairs[1] != 5 || res.Pairs[3] != 4 {
+		t.Errorf("Expected maximum number of pairs, got %v", res.Pairs)
+	}
+}
+
+func TestMinCostOverflow(t *testing.T) {
+	gr := buildGraph(t, true, false, 2, [3]uint64{1, 2, 1})
+	gr.Edges[1].UpdateEdge(graph.WithEdgeCost(1 << 62))
+	if _, err := algo.MinCostMaxFlow(gr, 1, 2); err == nil {
+		t.Error("Expected error for too expensive edge")
+	}
+
+	// Every cost is fine, but total cost is 4 * 2^63
+	gr.Edges[1].UpdateEdge(graph.WithEdgeCost(4), graph.WithEdgeWeight(1<<63))
+	if _, err := algo.MinCostMaxFlow(gr, 1, 2); err == nil {
+		t.Error("Expected error for too big total cost")
+	}
+
+	heavy := buildGraph(t, false, false, 2, [3]uint64{1, 2, 1 << 62})
+	if _, err := algo.HungarianAssignment(heavy, []graph.TKey{1}, false); err == nil {
+		t.Error("Expected error for too heavy edge")
+	}
+}
@@ -0,0 +1,211 @@
+package graph_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/generate"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+// Faces of rotation system must satisfy Euler's formula V - E + F = 2
+func checkEmbedding(t *testing.T, name string, gr *graph.Graph, embedding map[graph.TKey][]graph.TKey) {
+	t.Helper()
+	edges := make(map[[2]graph.TKey]bool)
+	for _, edge := range gr.Edges {
+		if edge.Source != edge.Destination {
+			edges[[2]graph.TKey{edge.Source, edge.Destination}] = true
+			edges[[2]graph.TKey{edge.Destination, edge.Source}] = true
+		}
+	}
+
+	darts := 0
+	for v, neighbours := range embedding {
+		darts += len(neighbours)
+		for _, w := range neighbours {
+			if !edges[[2]graph.TKey{v, w}] {
+				t.Fatalf("%s: embedding has no such edge %d-%d", name, v, w)
+			}
+		}
+	}
+	if darts != len(edges) {
+		t.Fatalf("%s: embedding has %d darts, expected %d", name, darts, len(edges))
+	}
+
+	visited := make(map[[2]graph.TKey]bool)
+	faces := 0
+	for dart := range edges {
+		if visited[dart] {
+			continue
+		}
+		faces++
+		for !visited[dart] {
+			visited[dart] = true
+			u, v := dart[0], dart[1]
+			rotation := embedding[v]
+			i := slices.Index(rotation, u)
+			dart = [2]graph.TKey{v, rotation[(i+1)%len(rotation)]}
+		}
+	}
+	if len(gr.Nodes)-len(edges)/2+faces != 2 {
+		t.Errorf("%s: %d nodes, %d edges and %d faces break Euler's formula", name, len(gr.Nodes), len(edges)/2, faces)
+	}
+}
+
+// Witness must be non-planar, and removing any its edge must make it planar
+func checkWitness(t *testing.T, name string, gr *graph.Graph, result *algo.PlanarityResult) {
+	t.Helper()
+	witness, err := algo.EdgeInducedSubgraph(gr, result.Kuratowski)
+	if err != nil {
+		t.Fatalf("%s: bad witness: %v", name, err)
+	}
+	if algo.IsPlanar(witness) {
+		t.Fatalf("%s: witness is planar", name)
+	}
+	for _, key := range result.Kuratowski {
+		smaller := witness.Copy()
+		smaller.RemoveEdgeByKey(key)
+		if !algo.IsPlanar(smaller) {
+			t.Fatalf("%s: witness stays non-planar without edge %d", name, key)
+		}
+	}
+}
+
+func completeBipartite(t *testing.T, a, b int) *graph.Graph {
+	var edges [][3]uint64
+	for u := 1; u <= a; u++ {
+		for v := a + 1; v <= a+b; v++ {
+			edges = append(edges, [3]uint64{uint64(u), uint64(v), 0})
+		}
+	}
+	return buildGraph(t, false, false, a+b, edges...)
+}
+
+func TestPlanarGraphs(t *testing.T) {
+	cube, _ := generate.Hypercube(3)
+	tests := []struct {
+		name string
+		gr   *graph.Graph
+	}{
+		{"K4", generate.Complete(4)},
+		{"Grid", generate.Grid(6, 7)},
+		{"Wheel", generate.Wheel(12)},
+		{"Cube", cube},
+		{"Tree", generate.RandomTree(40, generate.WithSeed(3))},
+		{"K2,5", completeBipartite(t, 2, 5)},
+		// Octahedron is maximal planar: 6 nodes, 12 = 3 * 6 - 6 edges
+		{"Octahedron", buildGraph(t, false, false, 6,
+			[3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0}, [3]uint64{1, 5, 0},
+			[3]uint64{6, 2, 0}, [3]uint64{6, 3, 0}, [3]uint64{6, 4, 0}, [3]uint64{6, 5, 0},
+			[3]uint64{2, 3, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 5, 0}, [3]uint64{5, 2, 0})},
+		// Directions, parallel edges and loops do not matter
+		{"Directed multi K4", buildGraph(t, true, true, 4,
+			[3]uint64{1, 2, 0}, [3]uint64{2, 1, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0},
+			[3]uint64{2, 3, 0}, [3]uint64{2, 4, 0}, [3]uint64{3, 4, 0}, [3]uint64{4, 4, 0})},
+	}
+	for _, test := range tests {
+		result, err := algo.Planarity(test.gr)
+		if err != nil || !result.IsPlanar || !algo.IsPlanar(test.gr) {
+			t.Errorf("%s should be planar", test.name)
+			continue
+		}
+		checkEmbedding(t, test.name, test.gr, result.Embedding)
+	}
+}
+
+func TestNonPlanarGraphs(t *testing.T) {
+	tests := []struct {
+		name string
+		gr   *graph.Graph
+		kind string
+	}{
+		{"K5", generate.Complete(5), "K5"},
+		{"K3,3", completeBipartite(t, 3, 3), "K3,3"},
+		{"Petersen", generate.Petersen(), "K3,3"},
+		{"K6", generate.Complete(6), ""},
+		{"K4,4", completeBipartite(t, 4, 4), "K3,3"},
+	}
+	for _, test := range tests {
+		result, err := algo.Planarity(test.gr)
+		if err != nil {
+			t.Fatalf("%s: planarity failed: %v", test.name, err)
+		}
+		if result.IsPlanar || algo.IsPlanar(test.gr) {
+			t.Errorf("%s should not be planar", test.name)
+			continue
+		}
+		if test.kind != "" && result.Kind != test.kind {
+			t.Errorf("%s: expected %s witness, got %s", test.name, test.kind, result.Kind)
+		}
+		checkWitness(t, test.name, test.gr, result)
+	}
+
+	if result, _ := algo.Planarity(generate.Complete(5)); len(result.Kuratowski) != 10 {
+		t.Errorf("Witness for K5 should be all 10 edges, got %v", result.Kuratowski)
+	}
+
+	// Subdivided K3,3 with hanging tree is shrunk back to K3,3 for witness
+	subdivided := completeBipartite(t, 3, 3)
+	next := graph.TKey(100)
+	for _, key := range slices.Sorted(maps.Keys(subdivided.Edges)) {
+		edge := subdivided.Edges[key]
+		subdivided.RemoveEdgeByKey(key)
+		previous := edge.Source
+		for range 99 {
+			subdivided.Nodes[next] = graph.MakeNode(next)
+			subdivided.Edges[next] = graph.MakeEdge(next, previous, next)
+			previous = next
+			next++
+		}
+		subdivided.Edges[next] = graph.MakeEdge(next, previous, edge.Destination)
+		next++
+	}
+	for range 500 {
+		subdivided.Nodes[next] = graph.MakeNode(next)
+		subdivided.Edges[next] = graph.MakeEdge(next, next-1, next)
+		next++
+	}
+	subdivided.RebuildAdjacencyMap()
+	result, err := algo.Planarity(subdivided)
+	if err != nil || result.IsPlanar || result.Kind != "K3,3" || len(result.Kuratowski) != 9*100 {
+		t.Fatalf("Expected whole subdivided K3,3 as witness, got error %v", err)
+	}
+	checkWitness(t, "Subdivided K3,3", subdivided, result)
+
+	// Big planar graph still gets embedding, big non-planar one gets no witness
+	grid := generate.Grid(101, 100)
+	if result, err := algo.Planarity(grid); err != nil || !result.IsPlanar {
+		t.Errorf("Big grid should be planar, got error %v", err)
+	}
+	grid.Edges[1000000] = graph.MakeEdge(1000000, 1, 10100)
+	grid.Edges[1000001] = graph.MakeEdge(1000001, 100, 10001)
+	grid.RebuildAdjacencyMap()
+	result, err = algo.Planarity(grid)
+	if err != nil || result.IsPlanar || result.Kuratowski != nil || result.Note == "" || algo.IsPlanar(grid) {
+		t.Errorf("Expected non-planar result without witness for big graph, got error %v", err)
+	}
+}
+
+func TestPlanarityRandom(t *testing.T) {
+	planar := 0
+	for seed := range uint64(60) {
+		gr, _ := generate.ErdosRenyi(12, 0.25, generate.WithSeed(seed))
+		result, err := algo.Planarity(gr)
+		if err != nil {
+			t.Fatalf("Planarity failed: %v", err)
+		}
+		if result.IsPlanar {
+			planar++
+			if algo.IsConnected(gr) {
+				checkEmbedding(t, "Random", gr, result.Embedding)
+			}
+		} else {
+			checkWitness(t, "Random", gr, result)
+		}
+	}
+	if planar == 0 || planar == 60 {
+		t.Errorf("Expected both planar and non-planar random graphs, got %d planar", planar)
+	}
+}
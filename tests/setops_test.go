@@ -0,0 +1,117 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/generate"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestSetOperations(t *testing.T) {
+	// a: path 1-2-3, b: nodes 1..4 with edges 3-2 and 3-4, edge keys collide
+	a := buildGraph(t, false, false, 3, [3]uint64{1, 2, 1}, [3]uint64{2, 3, 2})
+	b := buildGraph(t, false, false, 4, [3]uint64{3, 2, 9}, [3]uint64{3, 4, 5})
+
+	union, mapping, err := algo.Union(a, b)
+	if err != nil {
+		t.Fatalf("Union failed: %v", err)
+	}
+	if len(union.Nodes) != 4 || len(union.Edges) != 3 {
+		t.Errorf("Unexpected union with %d nodes and %d edges", len(union.Nodes), len(union.Edges))
+	}
+	if mapping.Edges[1] != 2 || mapping.Edges[2] != 3 || union.Edges[2].Weight != 2 || union.Edges[3].Weight != 5 {
+		t.Errorf("Unexpected union edge mapping %v", mapping.Edges)
+	}
+
+	inter, mapping, _ := algo.Intersection(a, b)
+	if len(inter.Nodes) != 3 || len(inter.Edges) != 1 || inter.Edges[2] == nil || mapping.Edges[1] != 2 {
+		t.Errorf("Unexpected intersection %v", inter.Edges)
+	}
+
+	diff, _, _ := algo.Difference(a, b)
+	if len(diff.Nodes) != 3 || len(diff.Edges) != 1 || diff.Edges[1] == nil {
+		t.Errorf("Unexpected difference %v", diff.Edges)
+	}
+
+	sym, mapping, _ := algo.SymmetricDifference(a, b)
+	if len(sym.Nodes) != 4 || len(sym.Edges) != 2 || sym.Edges[1] == nil || mapping.Edges[2] != 3 {
+		t.Errorf("Unexpected symmetric difference %v, mapping %v", sym.Edges, mapping.Edges)
+	}
+
+	directed := buildGraph(t, true, false, 1)
+	if _, _, err := algo.Union(a, directed); err == nil {
+		t.Error("Expected error for directed and undirected graphs")
+	}
+}
+
+func TestDisjointUnionAndJoin(t *testing.T) {
+	a := buildGraph(t, false, false, 2, [3]uint64{1, 2, 0})
+	b := buildGraph(t, false, false, 3, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0})
+
+	union, mapping, err := algo.DisjointUnion(a, b)
+	if err != nil {
+		t.Fatalf("Disjoint union failed: %v", err)
+	}
+	if len(union.Nodes) != 5 || len(union.Edges) != 3 || mapping.Nodes[1] != 3 || mapping.Edges[2] != 3 {
+		t.Errorf("Unexpected disjoint union, mapping %+v", mapping)
+	}
+	if edge := union.Edges[3]; edge.Source != 4 || edge.Destination != 5 {
+		t.Errorf("Edge 2-3 of b should become 4-5, got %d-%d", edge.Source, edge.Destination)
+	}
+
+	join, _, _ := algo.Join(a, b)
+	if len(join.Nodes) != 5 || len(join.Edges) != 3+2*3 {
+		t.Errorf("Unexpected join with %d edges", len(join.Edges))
+	}
+
+	complement, err := algo.Complement(b)
+	if err != nil || len(complement.Edges) != 1 {
+		t.Errorf("Complement of path 1-2-3 should be the single edge 1-3, got %d edges", len(complement.Edges))
+	}
+
+	// Complement of a path on 2000 nodes has about 2 million edges
+	if _, err := algo.Complement(generate.Path(2000)); err == nil {
+		t.Error("Expected error for too big complement")
+	}
+	if _, _, err := algo.Join(generate.Path(1500), generate.Path(1500)); err == nil {
+		t.Error("Expected error for too big join")
+	}
+}
+
+func TestProducts(t *testing.T) {
+	// K2 and path P3
+	k2 := buildGraph(t, false, false, 2, [3]uint64{1, 2, 1})
+	p3 := buildGraph(t, false, false, 3, [3]uint64{1, 2, 2}, [3]uint64{2, 3, 2})
+
+	cases := []struct {
+		name    string
+		product func(a, b *graph.Graph) (*graph.Graph, map[graph.TKey][2]graph.TKey, error)
+		edges   int
+	}{
+		{"cartesian", algo.CartesianProduct, 3 + 2*2}, // ladder
+		{"tensor", algo.TensorProduct, 2 * 1 * 2},
+		{"strong", algo.StrongProduct, 7 + 4},
+		{"lexicographic", algo.LexicographicProduct, 3*3 + 2*2},
+	}
+	for _, c := range cases {
+		gr, pairs, err := c.product(k2, p3)
+		if err != nil {
+			t.Fatalf("%s product failed: %v", c.name, err)
+		}
+		if len(gr.Nodes) != 6 || len(gr.Edges) != c.edges {
+			t.Errorf("%s product: expected 6 nodes and %d edges, got %d and %d", c.name, c.edges, len(gr.Nodes), len(gr.Edges))
+		}
+		if pairs[4] != [2]graph.TKey{2, 1} || gr.Nodes[4].Label != "(2, 1)" {
+			t.Errorf("%s product: unexpected pair %v for key 4", c.name, pairs[4])
+		}
+	}
+
+	// Too many nodes, and too many edges from only 20000 nodes
+	if _, _, err := algo.CartesianProduct(generate.Path(1001), generate.Path(1000)); err == nil {
+		t.Error("Expected error for too many nodes in product")
+	}
+	if _, _, err := algo.LexicographicProduct(generate.Path(200), generate.Path(100)); err == nil {
+		t.Error("Expected error for too many edges in product")
+	}
+}
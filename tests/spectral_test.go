@@ -0,0 +1,131 @@
+package graph_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/generate"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestMatrices(t *testing.T) {
+	// Path 1-2-3 with weights 2 and 3, plus a self-loop at 1
+	gr := buildGraph(t, false, true, 3, [3]uint64{1, 2, 2}, [3]uint64{2, 3, 3}, [3]uint64{1, 1, 5})
+
+	keys, adjacency := algo.AdjacencyMatrix(gr, true)
+	if len(keys) != 3 || adjacency[0][1] != 2 || adjacency[1][0] != 2 || adjacency[0][0] != 5 {
+		t.Errorf("Unexpected adjacency matrix %v", adjacency)
+	}
+	_, degree := algo.DegreeMatrix(gr, false)
+	if degree[0][0] != 2 || degree[1][1] != 2 || degree[0][1] != 0 {
+		t.Errorf("Unexpected degree matrix %v", degree)
+	}
+
+	_, laplacian := algo.LaplacianMatrix(gr, true)
+	expected := [][]float64{{2, -2, 0}, {-2, 5, -3}, {0, -3, 3}}
+	for i := range expected {
+		for j := range expected[i] {
+			if laplacian[i][j] != expected[i][j] {
+				t.Fatalf("Expected Laplacian %v, got %v", expected, laplacian)
+			}
+		}
+	}
+
+	_, normalized := algo.NormalizedLaplacianMatrix(gr, false)
+	if !almostEqual(normalized[0][0], 1) || !almostEqual(normalized[0][1], -1/math.Sqrt(2)) {
+		t.Errorf("Unexpected normalized Laplacian %v", normalized)
+	}
+}
+
+func TestJacobiEigen(t *testing.T) {
+	values, vectors := algo.JacobiEigen([][]float64{{2, 1}, {1, 2}})
+	if !almostEqual(values[0], 1) || !almostEqual(values[1], 3) {
+		t.Errorf("Expected eigenvalues 1 and 3, got %v", values)
+	}
+	if !almostEqual(vectors[1][0], 1/math.Sqrt(2)) || !almostEqual(vectors[1][1], 1/math.Sqrt(2)) {
+		t.Errorf("Unexpected eigenvector %v", vectors[1])
+	}
+
+	// Cycle C_n has adjacency eigenvalues 2 cos(2 pi k / n)
+	values, _ = algo.AdjacencySpectrum(generate.Cycle(6), false)
+	expected := []float64{-2, -1, -1, 1, 1, 2}
+	for i := range expected {
+		if !almostEqual(values[i], expected[i]) {
+			t.Errorf("Expected spectrum %v, got %v", expected, values)
+			break
+		}
+	}
+
+	// K_n has Laplacian eigenvalues 0 and n with multiplicity n - 1
+	values, _ = algo.LaplacianSpectrum(generate.Complete(5), false)
+	if !almostEqual(values[0], 0) || !almostEqual(values[1], 5) || !almostEqual(values[4], 5) {
+		t.Errorf("Unexpected Laplacian spectrum of K5 %v", values)
+	}
+
+	_, normalized := algo.NormalizedLaplacianMatrix(generate.Cycle(6), false)
+	values, _ = algo.JacobiEigen(normalized)
+	if !almostEqual(values[0], 0) || !almostEqual(values[5], 2) {
+		t.Errorf("Normalized spectrum of bipartite graph should go from 0 to 2, got %v", values)
+	}
+}
+
+func TestAlgebraicConnectivity(t *testing.T) {
+	connectivity, fiedler, err := algo.AlgebraicConnectivity(generate.Path(5), false)
+	if err != nil {
+		t.Fatalf("Algebraic connectivity failed: %v", err)
+	}
+	if expected := 2 * (1 - math.Cos(math.Pi/5)); !almostEqual(connectivity, expected) {
+		t.Errorf("Expected %f, got %f", expected, connectivity)
+	}
+	// Fiedler vector of path is monotone and antisymmetric, first component is positive
+	for key := graph.TKey(1); key < 5; key++ {
+		if fiedler[key] <= fiedler[key+1] {
+			t.Errorf("Fiedler vector should decrease along path, got %v", fiedler)
+			break
+		}
+	}
+	if !almostEqual(fiedler[3], 0) || !almostEqual(fiedler[1], -fiedler[5]) {
+		t.Errorf("Unexpected Fiedler vector %v", fiedler)
+	}
+
+	disconnected := buildGraph(t, false, false, 4, [3]uint64{1, 2, 0}, [3]uint64{3, 4, 0})
+	if connectivity, _, _ := algo.AlgebraicConnectivity(disconnected, false); !almostEqual(connectivity, 0) {
+		t.Errorf("Disconnected graph should have zero connectivity, got %f", connectivity)
+	}
+
+	big, _ := generate.ErdosRenyi(algo.SpectralNodesLimit+1, 0.01)
+	if _, _, err := algo.AlgebraicConnectivity(big, false); err == nil {
+		t.Error("Expected error for too big graph")
+	}
+}
+
+func TestSpanningTreeCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		gr       *graph.Graph
+		expected int64
+	}{
+		{"K6", generate.Complete(6), 1296},
+		{"C7", generate.Cycle(7), 7},
+		{"Petersen", generate.Petersen(), 2000},
+		// Double edge 1-2, edge 2-3 and a self-loop
+		{"Multigraph", buildGraph(t, false, true, 3,
+			[3]uint64{1, 2, 0}, [3]uint64{1, 2, 0}, [3]uint64{2, 3, 0}, [3]uint64{3, 3, 0}), 2},
+		{"Disconnected", buildGraph(t, false, false, 3, [3]uint64{1, 2, 0}), 0},
+		{"Single node", generate.Path(1), 1},
+		{"Empty", graph.MakeGraph(), 0},
+	}
+	for _, test := range tests {
+		count, err := algo.SpanningTreeCount(test.gr)
+		if err != nil || count.Int64() != test.expected {
+			t.Errorf("%s: expected %d spanning trees, got %v (error %v)", test.name, test.expected, count, err)
+		}
+	}
+
+	// K_n has n^(n-2) spanning trees, more than fits into int64 for n = 20
+	count, _ := algo.SpanningTreeCount(generate.Complete(20))
+	if count.String() != "262144000000000000000000" {
+		t.Errorf("Expected 20^18 spanning trees of K20, got %v", count)
+	}
+}
@@ -0,0 +1,132 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/generate"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+// Tree with edges 1-2, 1-3, 2-4, 2-5, 3-6 of weight 1 and 6-7 of weight 10
+func buildTree(t *testing.T) *graph.Graph {
+	return buildGraph(t, false, false, 7,
+		[3]uint64{1, 2, 1}, [3]uint64{1, 3, 1}, [3]uint64{2, 4, 1},
+		[3]uint64{2, 5, 1}, [3]uint64{3, 6, 1}, [3]uint64{6, 7, 10})
+}
+
+func TestIsTreeAndForest(t *testing.T) {
+	tree := buildTree(t)
+	if !algo.IsTree(tree) || !algo.IsForest(tree) {
+		t.Error("Expected tree")
+	}
+
+	forest := buildGraph(t, false, false, 4, [3]uint64{1, 2, 0}, [3]uint64{3, 4, 0})
+	if algo.IsTree(forest) || !algo.IsForest(forest) {
+		t.Error("Two edges on four nodes should be a forest, but not a tree")
+	}
+
+	cycle := generate.Cycle(4)
+	if algo.IsTree(cycle) || algo.IsForest(cycle) {
+		t.Error("Cycle is neither tree nor forest")
+	}
+	parallel := buildGraph(t, false, true, 3, [3]uint64{1, 2, 0}, [3]uint64{1, 2, 0})
+	if algo.IsTree(parallel) || algo.IsForest(parallel) {
+		t.Error("Parallel edges make a cycle")
+	}
+	if algo.IsTree(graph.MakeGraph()) || !algo.IsForest(graph.MakeGraph()) {
+		t.Error("Empty graph is a forest, but not a tree")
+	}
+	if _, err := algo.TreeCenter(cycle); err == nil {
+		t.Error("Expected error for cycle")
+	}
+}
+
+func TestPrufer(t *testing.T) {
+	code, err := algo.PruferEncode(buildTree(t))
+	if err != nil {
+		t.Fatalf("Encoding failed: %v", err)
+	}
+	if expected := []graph.TKey{2, 2, 1, 3, 6}; !reflect.DeepEqual(code, expected) {
+		t.Errorf("Expected code %v, got %v", expected, code)
+	}
+
+	for seed := range uint64(10) {
+		tree := generate.RandomTree(12, generate.WithSeed(seed))
+		code, err := algo.PruferEncode(tree)
+		if err != nil {
+			t.Fatalf("Encoding failed: %v", err)
+		}
+		decoded, err := algo.PruferDecode(code)
+		if err != nil {
+			t.Fatalf("Decoding failed: %v", err)
+		}
+		if again, _ := algo.PruferEncode(decoded); !reflect.DeepEqual(code, again) {
+			t.Errorf("Round trip changed code %v to %v", code, again)
+		}
+		if _, ok := algo.Isomorphism(tree, decoded); !ok {
+			t.Error("Decoded tree differs from original")
+		}
+	}
+
+	if _, err := algo.PruferDecode([]graph.TKey{5}); err == nil {
+		t.Error("Expected error for element out of range")
+	}
+}
+
+func TestTreeCenterAndDiameter(t *testing.T) {
+	tree := buildTree(t)
+
+	center, err := algo.TreeCenter(tree)
+	if err != nil || !reflect.DeepEqual(center, []graph.TKey{1, 3}) {
+		t.Errorf("Expected center [1 3], got %v (error %v)", center, err)
+	}
+
+	path, length, err := algo.TreeDiameter(tree, false)
+	if err != nil || length != 5 || len(path) != 6 {
+		t.Errorf("Expected diameter 5, got %d along %v (error %v)", length, path, err)
+	}
+	path, length, _ = algo.TreeDiameter(tree, true)
+	if length != 14 || path[0] != 7 && path[len(path)-1] != 7 {
+		t.Errorf("Expected weighted diameter 14 ending at 7, got %d along %v", length, path)
+	}
+
+	single := buildGraph(t, false, false, 1)
+	if center, _ := algo.TreeCenter(single); !reflect.DeepEqual(center, []graph.TKey{1}) {
+		t.Errorf("Center of single node should be itself, got %v", center)
+	}
+}
+
+func TestRootTreeAndLCA(t *testing.T) {
+	rooted, err := algo.RootTree(buildTree(t), 3)
+	if err != nil {
+		t.Fatalf("Rooting failed: %v", err)
+	}
+	if rooted.Parent[1] != 3 || rooted.Depth[4] != 3 || !reflect.DeepEqual(rooted.Children[3], []graph.TKey{1, 6}) {
+		t.Errorf("Unexpected rooted tree %+v", rooted)
+	}
+	if _, ok := rooted.Parent[3]; ok || rooted.Order[0] != 3 {
+		t.Error("Root should have no parent and go first")
+	}
+
+	lca := algo.MakeLCA(rooted)
+	tests := []struct{ u, v, ancestor graph.TKey }{
+		{4, 5, 2}, {4, 7, 3}, {2, 4, 2}, {7, 7, 7}, {5, 1, 1},
+	}
+	for _, test := range tests {
+		if ancestor, err := lca.Query(test.u, test.v); err != nil || ancestor != test.ancestor {
+			t.Errorf("LCA(%d, %d) should be %d, got %d", test.u, test.v, test.ancestor, ancestor)
+		}
+	}
+	if distance, _ := lca.Distance(4, 7); distance != 5 {
+		t.Errorf("Distance between 4 and 7 should be 5, got %d", distance)
+	}
+	if _, err := lca.Query(4, 99); err == nil {
+		t.Error("Expected error for missing node")
+	}
+
+	if _, err := algo.RootTree(generate.Cycle(3), 1); err == nil {
+		t.Error("Expected error for cycle")
+	}
+}
@@ -0,0 +1,83 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+// Complete graph on 5 nodes: cheap ring 1-2-3-4-5 and expensive chords
+func buildRingGraph(t *testing.T) *graph.Graph {
+	return buildGraph(t, false, false, 5,
+		[3]uint64{1, 2, 1}, [3]uint64{2, 3, 1}, [3]uint64{3, 4, 1}, [3]uint64{4, 5, 1}, [3]uint64{5, 1, 1},
+		[3]uint64{1, 3, 5}, [3]uint64{1, 4, 5}, [3]uint64{2, 4, 5}, [3]uint64{2, 5, 5}, [3]uint64{3, 5, 5})
+}
+
+func TestHeldKarpTSP(t *testing.T) {
+	tour, err := algo.HeldKarpTSP(context.Background(), buildRingGraph(t))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tour.Cost != 5 || len(tour.Nodes) != 5 || len(tour.Edges) != 5 {
+		t.Errorf("Expected ring tour of cost 5, got %v with cost %d", tour.Nodes, tour.Cost)
+	}
+
+	directed := buildGraph(t, true, false, 3, [3]uint64{1, 2, 1}, [3]uint64{2, 3, 1}, [3]uint64{1, 3, 1})
+	if _, err := algo.HeldKarpTSP(context.Background(), directed); err == nil {
+		t.Errorf("Expected error for graph without Hamiltonian cycle")
+	}
+
+	// Two nodes need two different edges, the lightest ones are used
+	single := buildGraph(t, false, true, 2, [3]uint64{1, 2, 1})
+	if _, err := algo.HeldKarpTSP(context.Background(), single); err == nil {
+		t.Errorf("Single edge is not a Hamiltonian cycle")
+	}
+	single.AddEdge(graph.MakeEdge(2, 2, 1, graph.WithEdgeWeight(7)))
+	single.AddEdge(graph.MakeEdge(3, 1, 2, graph.WithEdgeWeight(2)))
+	tour, err = algo.HeldKarpTSP(context.Background(), single)
+	if err != nil || tour.Cost != 3 || len(tour.Edges) != 2 || tour.Edges[0] != 1 || tour.Edges[1] != 3 {
+		t.Errorf("Expected tour by edges 1 and 3, got %v (error %v)", tour, err)
+	}
+}
+
+func TestHeuristicTSP(t *testing.T) {
+	for name, solve := range map[string]func(context.Context, *graph.Graph) (*algo.Tour, error){
+		"NearestNeighbour": algo.NearestNeighbourTSP,
+		"Christofides":     algo.ChristofidesTSP,
+	} {
+		tour, err := solve(context.Background(), buildRingGraph(t))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if tour.Cost != 5 || len(tour.Nodes) != 5 {
+			t.Errorf("%s: expected tour of cost 5, got %v with cost %d", name, tour.Nodes, tour.Cost)
+		}
+	}
+}
+
+func TestHamiltonianPath(t *testing.T) {
+	gr := buildGraph(t, true, false, 4, [3]uint64{1, 3, 0}, [3]uint64{3, 2, 0}, [3]uint64{2, 4, 0}, [3]uint64{1, 2, 0})
+	path, err := algo.HamiltonianPath(context.Background(), gr)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(path) != 4 || path[0] != 1 || path[3] != 4 {
+		t.Errorf("Unexpected Hamiltonian path %v", path)
+	}
+
+	star := buildGraph(t, false, false, 4, [3]uint64{1, 2, 0}, [3]uint64{1, 3, 0}, [3]uint64{1, 4, 0})
+	if _, err := algo.HamiltonianPath(context.Background(), star); err == nil {
+		t.Errorf("Expected error for star graph")
+	}
+}
+
+func TestTSPCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := algo.NearestNeighbourTSP(ctx, buildRingGraph(t)); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
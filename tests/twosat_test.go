@@ -0,0 +1,146 @@
+package graph_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/tolstovrob/graph-go/algo"
+	"github.com/tolstovrob/graph-go/graph"
+)
+
+func TestParseTwoSAT(t *testing.T) {
+	formula, err := algo.ParseTwoSAT("c example\n# another comment\np cnf 4 3\n1 -2 0\n\n2 3 0 -4\n0\n")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if formula.Variables != 4 || len(formula.Clauses) != 3 {
+		t.Fatalf("Expected 4 variables and 3 clauses, got %d and %d", formula.Variables, len(formula.Clauses))
+	}
+	if formula.Clauses[2] != [2]int{-4, -4} {
+		t.Errorf("Unit clause should be doubled, got %v", formula.Clauses[2])
+	}
+	if s := formula.String(); s != "(x1 ∨ ¬x2) ∧ (x2 ∨ x3) ∧ (¬x4)" {
+		t.Errorf("Unexpected formula %s", s)
+	}
+
+	// Several clauses on one line, clause split between lines, SATLIB ending
+	formula, err = algo.ParseTwoSAT("p cnf 3 4\n1 2 0 -1 3 0 2\n-3 0\n\n-2\n0\n%\n0\n")
+	if err != nil || len(formula.Clauses) != 4 || formula.Clauses[2] != [2]int{2, -3} || formula.Clauses[3] != [2]int{-2, -2} {
+		t.Errorf("Expected clauses split by zeros, got %v (error %v)", formula, err)
+	}
+
+	if formula, _ := algo.ParseTwoSAT("3 -7\n"); formula.Variables != 7 {
+		t.Errorf("Without header variables should be counted, got %d", formula.Variables)
+	}
+
+	bad := []string{
+		"1 2 3 0\n",
+		"1 2\n3 0\n",
+		"1 0 0\n",
+		"1 x\n",
+		"0\n",
+		"p cnf 2 1\n1 3\n",
+		"p cnf 2\n",
+		"p cnf 2 1\np cnf 2 1\n",
+		"1 5 0\np cnf 2 1\n",
+	}
+	for _, text := range bad {
+		if _, err := algo.ParseTwoSAT(text); err == nil {
+			t.Errorf("Expected error for %q", text)
+		}
+	}
+}
+
+func TestImplicationGraph(t *testing.T) {
+	formula := &algo.TwoSAT{Variables: 2, Clauses: [][2]int{{1, -2}, {2, 2}}}
+	gr := formula.ImplicationGraph()
+	if !gr.Options.IsDirected || len(gr.Nodes) != 4 || len(gr.Edges) != 3 {
+		t.Fatalf("Expected directed graph with 4 nodes and 3 edges, got %d and %d", len(gr.Nodes), len(gr.Edges))
+	}
+	if gr.Nodes[algo.LiteralKey(-2)].Label != "¬x2" {
+		t.Errorf("Unexpected label %s", gr.Nodes[algo.LiteralKey(-2)].Label)
+	}
+
+	// Every implication a → b comes with its contrapositive ¬b → ¬a
+	arcs := make(map[[2]graph.TKey]int)
+	for _, edge := range gr.Edges {
+		arcs[[2]graph.TKey{edge.Source, edge.Destination}]++
+	}
+	negate := func(key graph.TKey) graph.TKey {
+		if key%2 == 1 {
+			return key + 1
+		}
+		return key - 1
+	}
+	for arc, count := range arcs {
+		if arcs[[2]graph.TKey{negate(arc[1]), negate(arc[0])}] != count {
+			t.Errorf("Arc %v has no contrapositive", arc)
+		}
+	}
+}
+
+func TestSolveTwoSAT(t *testing.T) {
+	formula, _ := algo.ParseTwoSAT("1 2 0\n-1 3 0\n-2 -3 0\n2 -3 0\n")
+	assignment, conflict := formula.Solve()
+	if assignment == nil || conflict != 0 || !formula.Satisfied(assignment) {
+		t.Errorf("Expected satisfying assignment, got %v (conflict %d)", assignment, conflict)
+	}
+
+	// x2 forces x3 and ¬x3, x1 is fine
+	formula, _ = algo.ParseTwoSAT("1 -1 0\n-2 3 0\n-2 -3 0\n2 0\n")
+	if assignment, conflict := formula.Solve(); assignment != nil || conflict != 2 {
+		t.Errorf("Expected conflict on x2, got %v and %d", assignment, conflict)
+	}
+
+	empty := &algo.TwoSAT{}
+	if assignment, conflict := empty.Solve(); assignment == nil || conflict != 0 {
+		t.Error("Empty formula is satisfiable")
+	}
+}
+
+func TestSolveTwoSATRandom(t *testing.T) {
+	random := rand.New(rand.NewPCG(7, 7))
+	satisfiable := 0
+	for range 200 {
+		n := 1 + random.IntN(8)
+		formula := &algo.TwoSAT{Variables: n}
+		for range random.IntN(3 * n) {
+			var clause [2]int
+			for j := range clause {
+				clause[j] = 1 + random.IntN(n)
+				if random.IntN(2) == 0 {
+					clause[j] = -clause[j]
+				}
+			}
+			formula.Clauses = append(formula.Clauses, clause)
+		}
+
+		expected := false
+		for mask := range 1 << n {
+			assignment := make(map[int]bool)
+			for x := 1; x <= n; x++ {
+				assignment[x] = mask&(1<<(x-1)) != 0
+			}
+			if formula.Satisfied(assignment) {
+				expected = true
+				break
+			}
+		}
+
+		assignment, conflict := formula.Solve()
+		if (assignment != nil) != expected {
+			t.Fatalf("Formula %s: expected satisfiable %v, got %v", formula, expected, assignment != nil)
+		}
+		if assignment != nil {
+			satisfiable++
+			if !formula.Satisfied(assignment) {
+				t.Fatalf("Formula %s: assignment %v does not satisfy it", formula, assignment)
+			}
+		} else if conflict < 1 || conflict > n {
+			t.Fatalf("Formula %s: bad conflict variable %d", formula, conflict)
+		}
+	}
+	if satisfiable == 0 || satisfiable == 200 {
+		t.Errorf("Expected both satisfiable and unsatisfiable formulas, got %d satisfiable", satisfiable)
+	}
+}